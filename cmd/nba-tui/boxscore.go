@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"os"
+
+	"nba-tui/internal/export"
+)
+
+// runBoxScore prints the box score of a game in an export format.
+func runBoxScore(args []string) error {
+	fs := flag.NewFlagSet("boxscore", flag.ExitOnError)
+	clientOpts := registerClientFlags(fs)
+	format := fs.String("format", "csv", "Output format (csv|json|md)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("usage: nba-tui boxscore GAMEID [--format csv|json|md]")
+	}
+	gameID := fs.Arg(0)
+	// Allow flags after the game id as well
+	if err := fs.Parse(fs.Args()[1:]); err != nil {
+		return err
+	}
+
+	f, err := export.ParseFormat(*format, export.FormatCSV, export.FormatJSON, export.FormatMarkdown)
+	if err != nil {
+		return err
+	}
+
+	client, err := clientOpts.newClient()
+	if err != nil {
+		return err
+	}
+
+	res, err := client.GetBoxScore(gameID)
+	if err != nil {
+		return err
+	}
+	return export.WriteBoxScore(os.Stdout, res.Game, f)
+}
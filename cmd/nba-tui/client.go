@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"nba-tui/internal/nba"
+	"nba-tui/internal/ui/root"
+)
+
+// mockFlag accepts both a bare --mock and --mock=sim.
+type mockFlag string
+
+func (f *mockFlag) String() string { return string(*f) }
+
+func (f *mockFlag) Set(v string) error {
+	switch v {
+	case "true", "on", "static":
+		*f = "static"
+	case "false", "off", "":
+		*f = ""
+	case "sim":
+		*f = "sim"
+	default:
+		return fmt.Errorf("unknown mock mode %q (static|sim)", v)
+	}
+	return nil
+}
+
+func (f *mockFlag) IsBoolFlag() bool { return true }
+
+// clientOptions are the data source flags shared by every command.
+type clientOptions struct {
+	mock        mockFlag
+	record      *string
+	replay      *string
+	replaySpeed *float64
+}
+
+func registerClientFlags(fs *flag.FlagSet) *clientOptions {
+	opts := &clientOptions{}
+	fs.Var(&opts.mock, "mock", "Use mock data for testing (--mock for a static snapshot, --mock=sim for a simulated live game)")
+	opts.record = fs.String("record", "", "Record every response into the given directory")
+	opts.replay = fs.String("replay", "", "Replay responses recorded into the given directory")
+	opts.replaySpeed = fs.Float64("replay-speed", 1, "Playback speed multiplier for --replay")
+	return opts
+}
+
+func (o *clientOptions) newClient() (root.Client, error) {
+	var client root.Client
+	switch {
+	case *o.replay != "":
+		replayClient, err := nba.NewReplayClient(*o.replay, *o.replaySpeed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load replay: %w", err)
+		}
+		client = replayClient
+	case o.mock == "sim":
+		client = nba.NewSimClient(nba.DefaultSimScenario())
+	case o.mock == "static":
+		client = nba.NewMockClient()
+	default:
+		client = nba.NewClient()
+	}
+
+	if *o.record != "" {
+		recordingClient, err := nba.NewRecordingClient(client, *o.record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start recording: %w", err)
+		}
+		client = recordingClient
+	}
+	return client, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"nba-tui/internal/config"
+)
+
+const configUsage = "usage: nba-tui config init|show [flags]"
+
+// runConfig writes or prints the effective configuration: the config file
+// with any flags given on the command line applied.
+func runConfig(args []string) error {
+	if len(args) == 0 {
+		return errors.New(configUsage)
+	}
+	file, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("config "+args[0], flag.ExitOnError)
+	settings := registerSettingsFlags(fs, file)
+	force := false
+	if args[0] == "init" {
+		fs.BoolVar(&force, "force", false, "Overwrite an existing config file")
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	effective, err := settings.apply(file)
+	if err != nil {
+		return err
+	}
+
+	path, err := config.DefaultPath()
+	if err != nil {
+		return err
+	}
+	switch args[0] {
+	case "init":
+		if err := config.Create(path, effective, force); err != nil {
+			if errors.Is(err, os.ErrExist) {
+				return fmt.Errorf("%s already exists (use --force to overwrite)", path)
+			}
+			return err
+		}
+		fmt.Println("Wrote", path)
+		return nil
+	case "show":
+		fmt.Printf("# %s\n", path)
+		return config.Encode(os.Stdout, effective)
+	}
+	return errors.New(configUsage)
+}
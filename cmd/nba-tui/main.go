@@ -3,47 +3,131 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
 
-	"nba-tui/internal/nba"
+	"nba-tui/internal/config"
+	"nba-tui/internal/export"
 	"nba-tui/internal/ui/game_detail"
+	"nba-tui/internal/ui/keys"
 	"nba-tui/internal/ui/root"
+	"nba-tui/internal/ui/styles"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 func main() {
-	mock := flag.Bool("mock", false, "Use mock data for testing")
-	noDeco := flag.Bool("no-decoration", false, "Disable color decorations")
-	reload := flag.Int("reload", 30, "Reload interval in seconds (min 10s)")
-	kawaii := flag.String("kawaii", "on", "Enable kawaii mode (on|off)")
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "scores":
+			if err := runScores(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		case "boxscore":
+			if err := runBoxScore(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		case "config":
+			if err := runConfig(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		case "watch":
+			if err := runWatch(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		case "serve":
+			if err := runServe(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	fileConfig, err := loadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	settings := registerSettingsFlags(flag.CommandLine, fileConfig)
+	exportFormat := flag.String("export-format", "csv", "Box score export format for <e> in the detail view (csv|json|md)")
+	exportDir := flag.String("export-dir", ".", "Directory box score exports are written to")
 	flag.Parse()
 
-	if *reload < 10 {
-		*reload = 10
+	effective, err := settings.apply(fileConfig)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 
-	var client root.Client
-	if *mock {
-		client = nba.NewMockClient()
-	} else {
-		client = nba.NewClient()
+	client, err := settings.client.newClient()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	format, err := export.ParseFormat(*exportFormat, export.FormatCSV, export.FormatJSON, export.FormatMarkdown)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 
-	kawaiiMode := true
-	if *kawaii == "off" {
-		kawaiiMode = false
+	theme, err := styles.Lookup(effective.Theme)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 
 	config := game_detail.Config{
-		NoDecoration: *noDeco,
-		KawaiiMode:   kawaiiMode,
+		Theme:        theme,
+		KawaiiMode:   effective.Kawaii,
+		ExportFormat: format,
+		ExportDir:    *exportDir,
+		ColumnPreset: effective.BoxScore.Preset,
+		Columns:      effective.BoxScore.Columns,
+	}
+	m := root.NewModel(client, config, effective.Reload)
+	m.SetFavorites(effective.Favorites, saveFavorites)
+	m.SetCloseMargin(effective.Scoreboard.CloseMargin)
+	keymap, err := keys.Default().Apply(effective.Keys)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
-	m := root.NewModel(client, config, *reload)
+	m.SetKeyMap(keymap)
+	// Dry runs and terminal notifications would print over the TUI, so
+	// they only run in nba-tui watch
+	if effective.Webhook.DryRun {
+		effective.Webhook.URLs = nil
+	}
+	effective.Notify.Backends = tuiBackends(effective.Notify.Backends)
+	watcher, err := newWatcher(effective, io.Discard)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	m.SetWatcher(watcher)
 
 	p := tea.NewProgram(m, tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
-		fmt.Printf("there's been an error: %v", err)
+		fmt.Fprintf(os.Stderr, "there's been an error: %v\n", err)
 		os.Exit(1)
 	}
 }
+
+// saveFavorites writes the favorites toggled on the scoreboard back to the config file.
+func saveFavorites(tricodes []string) error {
+	path, err := config.DefaultPath()
+	if err != nil {
+		return err
+	}
+	return config.Set(path, "favorites", tricodes)
+}
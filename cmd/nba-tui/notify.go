@@ -0,0 +1,67 @@
+package main
+
+import (
+	"io"
+	"strings"
+
+	"nba-tui/internal/config"
+	"nba-tui/internal/notify"
+)
+
+// newWatcher builds the watcher of the notifications and webhooks in the
+// config. It is nil when neither is configured. Terminal backends and dry
+// runs write to w.
+func newWatcher(c config.Config, w io.Writer) (*notify.Watcher, error) {
+	var sinks notify.Multi
+
+	kinds, err := notify.ParseKinds(c.Notify.Events)
+	if err != nil {
+		return nil, err
+	}
+	var backends notify.Multi
+	for _, name := range c.Notify.Backends {
+		n, err := notify.NewBackend(name, w, c.Notify.Command)
+		if err != nil {
+			return nil, err
+		}
+		backends = append(backends, n)
+	}
+	if len(backends) > 0 {
+		sinks = append(sinks, notify.Only{Kinds: kinds, Notifier: backends})
+	}
+
+	kinds, err = notify.ParseKinds(c.Webhook.Events)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Webhook.URLs) > 0 {
+		webhook := notify.NewWebhook(c.Webhook.URLs)
+		webhook.Retries = c.Webhook.Retries
+		webhook.DryRun = c.Webhook.DryRun
+		webhook.W = w
+		sinks = append(sinks, notify.Only{Kinds: kinds, Notifier: webhook})
+	}
+
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	watcher := notify.NewWatcher(sinks)
+	watcher.Detector.CloseMargin = c.Notify.CloseMargin
+	watcher.Detector.Favorites = make(map[string]bool, len(c.Favorites))
+	for _, t := range c.Favorites {
+		watcher.Detector.Favorites[strings.ToUpper(t)] = true
+	}
+	return watcher, nil
+}
+
+// tuiBackends drops the terminal backends. Their escapes, written to the
+// terminal Bubble Tea is redrawing, would interleave with its output.
+func tuiBackends(names []string) []string {
+	var kept []string
+	for _, name := range names {
+		if name == "command" {
+			kept = append(kept, name)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"time"
+
+	"nba-tui/internal/export"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// runScores prints the scoreboard once and exits.
+func runScores(args []string) error {
+	fs := flag.NewFlagSet("scores", flag.ExitOnError)
+	clientOpts := registerClientFlags(fs)
+	format := fs.String("format", "table", "Output format (table|json|ndjson)")
+	date := fs.String("date", "", "Slate date as YYYY-MM-DD (default: today's live slate)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	f, err := export.ParseFormat(*format, export.FormatTable, export.FormatJSON, export.FormatNDJSON)
+	if err != nil {
+		return err
+	}
+
+	client, err := clientOpts.newClient()
+	if err != nil {
+		return err
+	}
+
+	var games []types.Game
+	if *date == "" {
+		games, err = client.GetScoreboard()
+	} else {
+		var day time.Time
+		day, err = time.ParseInLocation("2006-01-02", *date, time.Local)
+		if err != nil {
+			return err
+		}
+		games, err = client.GetScoreboardByDate(day)
+	}
+	if err != nil {
+		return err
+	}
+
+	return export.WriteScoreboard(os.Stdout, games, f)
+}
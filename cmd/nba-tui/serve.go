@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"nba-tui/internal/nba"
+	"nba-tui/internal/server"
+)
+
+// runServe serves the scoreboard, box scores and play-by-play as JSON, and
+// streams of live game updates, until interrupted.
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	clientOpts := registerClientFlags(fs)
+	addr := fs.String("addr", ":8080", "Address to listen on")
+	ttl := fs.Duration("ttl", 10*time.Second, "How long a fetched response is served to every client before it is fetched again")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *ttl <= 0 {
+		return fmt.Errorf("ttl must be positive, got %s", *ttl)
+	}
+
+	client, err := clientOpts.newClient()
+	if err != nil {
+		return err
+	}
+
+	handler := server.New(nba.NewCachingClient(client, *ttl))
+	// Polling faster than the cache expires would only resend its response
+	handler.PollInterval = *ttl
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	fmt.Printf("Serving on %s\n", *addr)
+	return srv.ListenAndServe()
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"strings"
+
+	"nba-tui/internal/config"
+	"nba-tui/internal/notify"
+	"nba-tui/internal/ui/game_detail"
+	"nba-tui/internal/ui/keys"
+	"nba-tui/internal/ui/styles"
+)
+
+// settingsFlags are the flags that override the config file.
+type settingsFlags struct {
+	fs      *flag.FlagSet
+	client  *clientOptions
+	noDeco  *bool
+	theme   *string
+	reload  *int
+	kawaii  *string
+	columns *string
+	notify  *string
+}
+
+// registerSettingsFlags registers the config file overrides on fs,
+// showing the file's values as their defaults.
+func registerSettingsFlags(fs *flag.FlagSet, file config.Config) *settingsFlags {
+	kawaii := "on"
+	if !file.Kawaii {
+		kawaii = "off"
+	}
+	return &settingsFlags{
+		fs:      fs,
+		client:  registerClientFlags(fs),
+		noDeco:  fs.Bool("no-decoration", false, "Disable color decorations; same as --theme no-decoration"),
+		theme:   fs.String("theme", file.Theme, "Color theme ("+strings.Join(styles.Names(), "|")+")"),
+		reload:  fs.Int("reload", file.Reload, "Reload interval in seconds (min 10s)"),
+		kawaii:  fs.String("kawaii", kawaii, "Enable kawaii mode (on|off)"),
+		columns: fs.String("columns", file.BoxScore.Preset, "Box score column preset (basic|shooting|full|advanced); overrides the config file"),
+		notify:  fs.String("notify", strings.Join(file.Notify.Backends, ","), "Comma-separated notification backends ("+strings.Join(notify.Backends, "|")+"); empty or off disables them, the terminal ones only run in nba-tui watch"),
+	}
+}
+
+// apply returns file with the parsed flags applied.
+func (f *settingsFlags) apply(file config.Config) (config.Config, error) {
+	set := map[string]bool{}
+	f.fs.Visit(func(fl *flag.Flag) { set[fl.Name] = true })
+
+	c := file
+	c.Theme = *f.theme
+	if *f.noDeco {
+		c.Theme = styles.NoDecoration().Name
+	}
+	c.Reload = max(*f.reload, 10)
+	c.Kawaii = *f.kawaii != "off"
+	if set["mock"] {
+		c.Mock = string(f.client.mock)
+	} else {
+		f.client.mock = mockFlag(file.Mock)
+	}
+	if set["columns"] {
+		c.BoxScore = config.BoxScore{Preset: *f.columns}
+	}
+	if set["notify"] {
+		c.Notify.Backends = nil
+		if *f.notify != "off" {
+			for _, name := range strings.Split(*f.notify, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					c.Notify.Backends = append(c.Notify.Backends, name)
+				}
+			}
+		}
+	}
+	if err := game_detail.ValidateColumns(c.BoxScore.Preset, c.BoxScore.Columns); err != nil {
+		return c, err
+	}
+	if _, err := keys.Default().Apply(c.Keys); err != nil {
+		return c, err
+	}
+	if _, err := styles.Lookup(c.Theme); err != nil {
+		return c, err
+	}
+	if _, err := newWatcher(c, io.Discard); err != nil {
+		return c, err
+	}
+	return c, c.Validate()
+}
+
+// loadConfig reads the config file from its default location.
+func loadConfig() (config.Config, error) {
+	path, err := config.DefaultPath()
+	if err != nil {
+		return config.Default(), nil // no config directory, use defaults
+	}
+	return config.Load(path)
+}
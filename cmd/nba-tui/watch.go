@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"nba-tui/internal/notify"
+	"nba-tui/internal/ui/root"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// runWatch runs the notifications and webhooks without the TUI, polling
+// today's games until interrupted.
+func runWatch(args []string) error {
+	file, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	settings := registerSettingsFlags(fs, file)
+	dryRun := fs.Bool("dry-run", file.Webhook.DryRun, "Print webhook payloads instead of sending them")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	effective, err := settings.apply(file)
+	if err != nil {
+		return err
+	}
+	effective.Webhook.DryRun = *dryRun
+
+	client, err := settings.client.newClient()
+	if err != nil {
+		return err
+	}
+	watcher, err := newWatcher(effective, os.Stdout)
+	if err != nil {
+		return err
+	}
+	if watcher == nil {
+		return errors.New("nothing to watch: configure notify backends or webhook urls")
+	}
+
+	w := &gameWatcher{client: client, watcher: watcher, live: map[string]bool{}}
+	ticker := time.NewTicker(time.Duration(effective.Reload) * time.Second)
+	defer ticker.Stop()
+	for {
+		if err := w.poll(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		<-ticker.C
+	}
+}
+
+// gameWatcher feeds the watcher from the scoreboard and the box scores of
+// the live games, which have the players for milestones.
+type gameWatcher struct {
+	client  root.Client
+	watcher *notify.Watcher
+	live    map[string]bool // live at the last poll
+}
+
+func (w *gameWatcher) poll() error {
+	games, err := w.client.GetScoreboard()
+	if err != nil {
+		return err
+	}
+	events := w.watcher.Detector.Scoreboard(games)
+
+	live := map[string]bool{}
+	var errs []error
+	for _, g := range games {
+		// A game that just ended may have had a milestone in its last minutes
+		if g.GameStatus != 2 && !w.live[g.GameId] {
+			continue
+		}
+		if g.GameStatus == 2 {
+			live[g.GameId] = true
+		}
+		box, err := w.client.GetBoxScore(g.GameId)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		events = append(events, w.watcher.Detector.Scoreboard([]types.Game{box.Game})...)
+	}
+	w.live = live
+
+	errs = append(errs, w.watcher.Deliver(events))
+	return errors.Join(errs...)
+}
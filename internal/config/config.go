@@ -0,0 +1,214 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the user's config file. Command line flags override it.
+type Config struct {
+	Reload     int                 `yaml:"reload"` // seconds
+	Kawaii     bool                `yaml:"kawaii"`
+	Mock       string              `yaml:"mock,omitempty"` // static or sim
+	Theme      string              `yaml:"theme"`
+	Keys       map[string][]string `yaml:"keys,omitempty"` // action -> keys
+	BoxScore   BoxScore            `yaml:"boxscore"`
+	Scoreboard Scoreboard          `yaml:"scoreboard"`
+	Notify     Notify              `yaml:"notify"`
+	Webhook    Webhook             `yaml:"webhook"`
+	Favorites  []string            `yaml:"favorites,omitempty"` // team tricodes
+}
+
+// Default returns the settings used for everything the file leaves out.
+func Default() Config {
+	return Config{
+		Reload:     30,
+		Kawaii:     true,
+		Theme:      "default",
+		BoxScore:   BoxScore{Preset: "full"},
+		Scoreboard: Scoreboard{CloseMargin: 5},
+		Notify: Notify{
+			Events:      []string{"game_start", "lead_change", "close_game", "overtime", "final", "favorite_score", "milestone"},
+			CloseMargin: 5,
+		},
+		Webhook: Webhook{
+			Events:  []string{"final", "lead_change", "milestone"},
+			Retries: 3,
+		},
+	}
+}
+
+// BoxScore selects the box score columns of the detail view.
+// Columns lists custom columns in display order and wins over Preset.
+type BoxScore struct {
+	Preset  string   `yaml:"preset,omitempty"`
+	Columns []string `yaml:"columns,omitempty"`
+}
+
+// Scoreboard configures the scoreboard's filters.
+type Scoreboard struct {
+	CloseMargin int `yaml:"close_margin"` // points, for the close games filter
+}
+
+// Notify configures notifications. They are off while Backends is empty.
+type Notify struct {
+	Events      []string `yaml:"events"`
+	Backends    []string `yaml:"backends,omitempty"` // bell, osc9, osc777 (watch only), command
+	Command     string   `yaml:"command,omitempty"`  // shell command of the command backend
+	CloseMargin int      `yaml:"close_margin"`       // points
+}
+
+// Webhook configures the webhooks events are POSTed to as JSON.
+type Webhook struct {
+	URLs    []string `yaml:"urls,omitempty"`
+	Events  []string `yaml:"events"`
+	Retries int      `yaml:"retries"`
+	DryRun  bool     `yaml:"dry_run,omitempty"` // print the payloads instead
+}
+
+// DefaultPath returns $XDG_CONFIG_HOME/nba-tui/config.yaml, falling back
+// to ~/.config when XDG_CONFIG_HOME is unset or relative, on every platform.
+func DefaultPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if !filepath.IsAbs(dir) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "nba-tui", "config.yaml"), nil
+}
+
+// Load reads the config file at path on top of Default.
+// A missing file is the default config.
+func Load(path string) (Config, error) {
+	config := Default()
+	data, err := os.ReadFile(path) // #nosec G304 -- user config path
+	if errors.Is(err, fs.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return config, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return config, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Validate checks the values the decoder cannot.
+func (c Config) Validate() error {
+	switch c.Mock {
+	case "", "static", "sim":
+	default:
+		return fmt.Errorf("unknown mock mode %q (static|sim)", c.Mock)
+	}
+	if c.Reload <= 0 {
+		return fmt.Errorf("reload must be positive, got %d", c.Reload)
+	}
+	if c.Scoreboard.CloseMargin < 0 {
+		return fmt.Errorf("scoreboard close_margin must not be negative, got %d", c.Scoreboard.CloseMargin)
+	}
+	if c.Notify.CloseMargin < 0 {
+		return fmt.Errorf("notify close_margin must not be negative, got %d", c.Notify.CloseMargin)
+	}
+	if c.Webhook.Retries < 0 {
+		return fmt.Errorf("webhook retries must not be negative, got %d", c.Webhook.Retries)
+	}
+	for _, u := range c.Webhook.URLs {
+		if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("webhook url %q is not an http(s) URL", u)
+		}
+	}
+	return nil
+}
+
+// Encode writes c as YAML.
+func Encode(w io.Writer, c Config) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// Create writes c to a new config file at path. It does not overwrite
+// an existing file unless force is set.
+func Create(path string, c Config, force bool) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if force {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flags, 0o600) // #nosec G304 -- user config path
+	if err != nil {
+		return err
+	}
+	if err := Encode(f, c); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Set stores value under the top-level key of the config file at path,
+// keeping the rest of the file, comments included.
+func Set(path, key string, value any) error {
+	var doc yaml.Node
+	data, err := os.ReadFile(path) // #nosec G304 -- user config path
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("parse %s: top level is not a mapping", path)
+	}
+
+	var valueNode yaml.Node
+	if err := valueNode.Encode(value); err != nil {
+		return err
+	}
+	replaced := false
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == key {
+			root.Content[i+1] = &valueNode
+			replaced = true
+		}
+	}
+	if !replaced {
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, &valueNode)
+	}
+
+	out, err := yaml.Marshal(&doc)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out, 0o600)
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeConfig(t, `
+boxscore:
+  preset: shooting
+  columns: [PTS, "+/-", MIN]
+`)
+	config, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "shooting", config.BoxScore.Preset)
+	assert.Equal(t, []string{"PTS", "+/-", "MIN"}, config.BoxScore.Columns)
+}
+
+func TestLoad_Missing(t *testing.T) {
+	config, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, Default(), config)
+}
+
+func TestLoad_Empty(t *testing.T) {
+	config, err := Load(writeConfig(t, ""))
+	assert.NoError(t, err)
+	assert.Equal(t, Default(), config)
+}
+
+func TestLoad_Settings(t *testing.T) {
+	path := writeConfig(t, `
+reload: 15
+kawaii: false
+mock: sim
+theme: monochrome
+keys:
+  quit: [ctrl+x]
+`)
+	config, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 15, config.Reload)
+	assert.False(t, config.Kawaii)
+	assert.Equal(t, "sim", config.Mock)
+	assert.Equal(t, "monochrome", config.Theme)
+	assert.Equal(t, map[string][]string{"quit": {"ctrl+x"}}, config.Keys)
+	// Left out of the file
+	assert.Equal(t, "full", config.BoxScore.Preset)
+	assert.Equal(t, Default().Notify, config.Notify)
+}
+
+func TestLoad_Notify(t *testing.T) {
+	path := writeConfig(t, `
+notify:
+  events: [final]
+  backends: [bell, command]
+  command: notify-send "$NBA_TUI_TITLE"
+`)
+	config, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, Notify{
+		Events:      []string{"final"},
+		Backends:    []string{"bell", "command"},
+		Command:     `notify-send "$NBA_TUI_TITLE"`,
+		CloseMargin: 5,
+	}, config.Notify)
+}
+
+func TestLoad_Webhook(t *testing.T) {
+	path := writeConfig(t, `
+webhook:
+  urls: [https://chat.example.com/hooks/nba]
+  dry_run: true
+`)
+	config, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, Webhook{
+		URLs:    []string{"https://chat.example.com/hooks/nba"},
+		Events:  []string{"final", "lead_change", "milestone"},
+		Retries: 3,
+		DryRun:  true,
+	}, config.Webhook)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	_, err := Load(writeConfig(t, "mock: live\n"))
+	assert.ErrorContains(t, err, "unknown mock mode")
+
+	_, err = Load(writeConfig(t, "reload: 0\n"))
+	assert.ErrorContains(t, err, "reload")
+
+	_, err = Load(writeConfig(t, "notify:\n  close_margin: -1\n"))
+	assert.ErrorContains(t, err, "notify close_margin")
+
+	_, err = Load(writeConfig(t, "scoreboard:\n  close_margin: -1\n"))
+	assert.ErrorContains(t, err, "scoreboard close_margin")
+
+	_, err = Load(writeConfig(t, "webhook:\n  urls: [chat.example.com/hook]\n"))
+	assert.ErrorContains(t, err, "not an http(s) URL")
+
+	_, err = Load(writeConfig(t, "webhook:\n  retries: -1\n"))
+	assert.ErrorContains(t, err, "retries")
+}
+
+func TestLoad_UnknownField(t *testing.T) {
+	_, err := Load(writeConfig(t, "boxscore:\n  colums: [PTS]\n"))
+	assert.ErrorContains(t, err, "colums")
+}
+
+func TestDefaultPath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/xdg")
+	path, err := DefaultPath()
+	assert.NoError(t, err)
+	assert.Equal(t, "/tmp/xdg/nba-tui/config.yaml", path)
+
+	for _, xdg := range []string{"", "relative"} {
+		t.Setenv("XDG_CONFIG_HOME", xdg)
+		t.Setenv("HOME", "/tmp/home")
+		path, err = DefaultPath()
+		assert.NoError(t, err)
+		assert.Equal(t, "/tmp/home/.config/nba-tui/config.yaml", path, xdg)
+	}
+}
+
+func TestSet(t *testing.T) {
+	path := writeConfig(t, `# my settings
+boxscore:
+  preset: basic # short
+favorites: [LAL]
+`)
+	assert.NoError(t, Set(path, "favorites", []string{"BOS", "LAL"}))
+
+	config, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BOS", "LAL"}, config.Favorites)
+	assert.Equal(t, "basic", config.BoxScore.Preset)
+
+	data, _ := os.ReadFile(path)
+	assert.Contains(t, string(data), "# my settings")
+	assert.Contains(t, string(data), "# short")
+}
+
+func TestSet_NewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nba-tui", "config.yaml")
+	assert.NoError(t, Set(path, "favorites", []string{"GSW"}))
+
+	config, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GSW"}, config.Favorites)
+}
+
+func TestCreate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nba-tui", "config.yaml")
+	want := Default()
+	want.Reload = 20
+	want.Favorites = []string{"LAL"}
+	assert.NoError(t, Create(path, want, false))
+
+	config, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, want, config)
+
+	// Existing files are kept unless forced
+	assert.Error(t, Create(path, Default(), false))
+	assert.NoError(t, Create(path, Default(), true))
+	config, err = Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, Default(), config)
+}
@@ -0,0 +1,233 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"nba-tui/internal/stats"
+	"nba-tui/internal/utils"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+const (
+	FormatCSV      Format = "csv"
+	FormatMarkdown Format = "md"
+)
+
+// BoxScoreColumns are the columns of the box score table in the detail view.
+var BoxScoreColumns = []string{
+	"PLAYER", "MIN", "FGM", "FGA", "FG%", "3PM", "3PA", "3P%", "FTM", "FTA", "FT%",
+	"OREB", "DREB", "REB", "AST", "STL", "BLK", "TO", "PF", "PTS", "+/-",
+}
+
+// Columns are BoxScoreColumns followed by the advanced stats, the
+// columns of every exported row.
+func Columns() []string {
+	return append(append([]string{}, BoxScoreColumns...), stats.Columns...)
+}
+
+// TeamBoxScore holds the plain rows of one team, advanced stats included.
+type TeamBoxScore struct {
+	Tricode string
+	Players [][]string
+	Total   []string // nil when the feed has no team statistics
+}
+
+func NewTeamBoxScore(team types.Team) TeamBoxScore {
+	t := TeamBoxScore{Tricode: team.TeamTricode}
+	if team.Players != nil {
+		for _, p := range *team.Players {
+			t.Players = append(t.Players, append(PlayerRow(p), stats.PlayerValues(p, team)...))
+		}
+	}
+	if team.Statistics != nil {
+		t.Total = append(TotalRow(*team.Statistics), stats.TeamValues(team)...)
+	}
+	return t
+}
+
+// formatMinutes drops the fractional seconds of a minutes clock,
+// keeping team totals such as "240:00" intact.
+func formatMinutes(clock string) string {
+	if i := strings.Index(clock, "."); i > 0 {
+		clock = clock[:i]
+	}
+	if len(clock) < 4 {
+		return "-"
+	}
+	return clock
+}
+
+func PlayerRow(p types.Player) []string {
+	name := strings.TrimSpace(p.FirstName + " " + p.FamilyName)
+	if p.Statistics == nil {
+		row := make([]string, len(BoxScoreColumns))
+		row[0] = name
+		row[1] = "-"
+		return row
+	}
+	box := *p.Statistics
+	return append(
+		append([]string{name, formatMinutes(box.MinutesClock())}, commonValues(box.CommonBoxScoreStatistic)...),
+		utils.PtrToFloatStr2f(box.PlusMinus),
+	)
+}
+
+func TotalRow(box types.TeamBoxScoreStatistic) []string {
+	min := "-"
+	if box.Minutes != "" {
+		min = formatMinutes(box.MinutesClock())
+	}
+	return append(
+		append([]string{"TOTAL", min}, commonValues(box.CommonBoxScoreStatistic)...),
+		"-",
+	)
+}
+
+// commonValues returns the stats between MIN and +/- in column order.
+func commonValues(box types.CommonBoxScoreStatistic) []string {
+	return []string{
+		utils.PtrToIntStr(box.FgM),
+		utils.PtrToIntStr(box.FgA),
+		utils.PtrToPctStr(box.FgPct),
+		utils.PtrToIntStr(box.Fg3M),
+		utils.PtrToIntStr(box.Fg3A),
+		utils.PtrToPctStr(box.Fg3Pct),
+		utils.PtrToIntStr(box.FtM),
+		utils.PtrToIntStr(box.FtA),
+		utils.PtrToPctStr(box.FtPct),
+		utils.PtrToIntStr(box.OReb),
+		utils.PtrToIntStr(box.DReb),
+		utils.PtrToIntStr(box.Reb),
+		utils.PtrToIntStr(box.Ast),
+		utils.PtrToIntStr(box.Stl),
+		utils.PtrToIntStr(box.Blk),
+		utils.PtrToIntStr(box.Tov),
+		utils.PtrToIntStr(box.PF),
+		utils.PtrToIntStr(box.Pts),
+	}
+}
+
+// BoxScoreFileName is the default file name used when exporting from the TUI.
+func BoxScoreFileName(gameID string, format Format) string {
+	return fmt.Sprintf("%s_boxscore.%s", gameID, format)
+}
+
+// WriteBoxScore writes both teams' player rows followed by their TOTAL row.
+func WriteBoxScore(w io.Writer, game types.Game, format Format) error {
+	teams := []TeamBoxScore{NewTeamBoxScore(game.HomeTeam), NewTeamBoxScore(game.AwayTeam)}
+
+	switch format {
+	case FormatCSV:
+		return writeBoxScoreCSV(w, teams)
+	case FormatJSON:
+		return writeBoxScoreJSON(w, game.GameId, teams)
+	case FormatMarkdown:
+		return writeBoxScoreMarkdown(w, game, teams)
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func writeBoxScoreCSV(w io.Writer, teams []TeamBoxScore) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"TEAM"}, Columns()...)); err != nil {
+		return err
+	}
+	for _, t := range teams {
+		rows := t.Players
+		if t.Total != nil {
+			rows = append(rows[:len(rows):len(rows)], t.Total)
+		}
+		for _, row := range rows {
+			if err := cw.Write(append([]string{t.Tricode}, row...)); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+type boxScoreJSON struct {
+	GameID string         `json:"gameId"`
+	Teams  []teamJSONRows `json:"teams"`
+}
+
+type teamJSONRows struct {
+	Tricode string              `json:"tricode"`
+	Players []map[string]string `json:"players"`
+	Total   map[string]string   `json:"total,omitempty"`
+}
+
+func rowObject(row []string) map[string]string {
+	columns := Columns()
+	obj := make(map[string]string, len(columns))
+	for i, col := range columns {
+		if i < len(row) {
+			obj[col] = row[i]
+		}
+	}
+	return obj
+}
+
+func writeBoxScoreJSON(w io.Writer, gameID string, teams []TeamBoxScore) error {
+	out := boxScoreJSON{GameID: gameID}
+	for _, t := range teams {
+		rows := teamJSONRows{Tricode: t.Tricode, Players: []map[string]string{}}
+		for _, p := range t.Players {
+			rows.Players = append(rows.Players, rowObject(p))
+		}
+		if t.Total != nil {
+			rows.Total = rowObject(t.Total)
+		}
+		out.Teams = append(out.Teams, rows)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func markdownRow(cells []string) string {
+	escaped := make([]string, len(cells))
+	for i, c := range cells {
+		escaped[i] = strings.ReplaceAll(c, "|", `\|`)
+	}
+	return "| " + strings.Join(escaped, " | ") + " |\n"
+}
+
+func writeBoxScoreMarkdown(w io.Writer, game types.Game, teams []TeamBoxScore) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s %d - %d %s\n",
+		game.HomeTeam.TeamTricode, game.HomeTeam.Score,
+		game.AwayTeam.Score, game.AwayTeam.TeamTricode,
+	)
+
+	columns := Columns()
+	align := make([]string, len(columns))
+	align[0] = ":---"
+	for i := 1; i < len(align); i++ {
+		align[i] = "---:"
+	}
+
+	for _, t := range teams {
+		fmt.Fprintf(&b, "\n### %s\n\n", t.Tricode)
+		b.WriteString(markdownRow(columns))
+		b.WriteString("| " + strings.Join(align, " | ") + " |\n")
+		for _, row := range t.Players {
+			b.WriteString(markdownRow(row))
+		}
+		if t.Total != nil {
+			total := append([]string{"**TOTAL**"}, t.Total[1:]...)
+			b.WriteString(markdownRow(total))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
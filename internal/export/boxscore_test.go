@@ -0,0 +1,152 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func testBoxScoreGame() types.Game {
+	home := []types.Player{
+		{
+			FirstName:  "LeBron",
+			FamilyName: "James",
+			Statistics: &types.PlayerBoxScoreStatistic{
+				CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
+					Minutes: "PT35M10.00S",
+					Pts:     ptr(30),
+					Reb:     ptr(10),
+					Ast:     ptr(8),
+					FgM:     ptr(10),
+					FgA:     ptr(20),
+					FgPct:   ptr(0.5),
+				},
+				PlusMinus: ptr(5.0),
+			},
+		},
+		{FamilyName: "Bench"},
+	}
+	away := []types.Player{
+		{
+			FirstName:  "Stephen",
+			FamilyName: "Curry",
+			Statistics: &types.PlayerBoxScoreStatistic{
+				CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Pts: ptr(28)},
+				PlusMinus:               ptr(-2.0),
+			},
+		},
+	}
+	return types.Game{
+		GameId: "0022500001",
+		HomeTeam: types.Team{
+			TeamTricode: "LAL",
+			Score:       110,
+			Players:     &home,
+			Statistics: &types.TeamBoxScoreStatistic{
+				CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
+					Minutes: "PT240M00.00S",
+					Pts:     ptr(110),
+				},
+			},
+		},
+		AwayTeam: types.Team{
+			TeamTricode: "GSW",
+			Score:       100,
+			Players:     &away,
+		},
+	}
+}
+
+func TestPlayerRow(t *testing.T) {
+	game := testBoxScoreGame()
+	row := PlayerRow((*game.HomeTeam.Players)[0])
+
+	assert.Len(t, row, len(BoxScoreColumns))
+	assert.Equal(t, "LeBron James", row[0])
+	assert.Equal(t, "35:10", row[1])
+	assert.Equal(t, "50.0", row[4])
+	assert.Equal(t, "30", row[19])
+	assert.Equal(t, "5", row[20])
+
+	empty := PlayerRow((*game.HomeTeam.Players)[1])
+	assert.Len(t, empty, len(BoxScoreColumns))
+	assert.Equal(t, []string{"Bench", "-"}, empty[:2])
+}
+
+func TestTotalRow(t *testing.T) {
+	game := testBoxScoreGame()
+	row := TotalRow(*game.HomeTeam.Statistics)
+
+	assert.Len(t, row, len(BoxScoreColumns))
+	assert.Equal(t, "TOTAL", row[0])
+	assert.Equal(t, "110", row[19])
+	assert.Equal(t, "-", row[20])
+}
+
+func TestWriteBoxScore(t *testing.T) {
+	game := testBoxScoreGame()
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, WriteBoxScore(&buf, game, FormatCSV))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		assert.NoError(t, err)
+		// header + 2 LAL players + LAL total + 1 GSW player
+		assert.Len(t, records, 5)
+		assert.Equal(t, append([]string{"TEAM"}, Columns()...), records[0])
+		assert.Equal(t, []string{"TS%", "eFG%", "AST/TO", "GmSc", "USG%"}, records[0][len(BoxScoreColumns)+1:])
+		assert.Equal(t, []string{"LAL", "LeBron James"}, records[1][:2])
+		assert.Equal(t, []string{"LAL", "TOTAL"}, records[3][:2])
+		assert.Equal(t, []string{"GSW", "Stephen Curry"}, records[4][:2])
+		for _, r := range records {
+			assert.Len(t, r, len(Columns())+1)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, WriteBoxScore(&buf, game, FormatJSON))
+
+		var got boxScoreJSON
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Equal(t, "0022500001", got.GameID)
+		assert.Len(t, got.Teams, 2)
+		assert.Equal(t, "30", got.Teams[0].Players[0]["PTS"])
+		assert.Equal(t, "110", got.Teams[0].Total["PTS"])
+		assert.Contains(t, got.Teams[0].Players[0], "TS%")
+		assert.Equal(t, "-", got.Teams[0].Total["USG%"])
+		assert.Nil(t, got.Teams[1].Total)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, WriteBoxScore(&buf, game, FormatMarkdown))
+
+		out := buf.String()
+		assert.Contains(t, out, "## LAL 110 - 100 GSW")
+		assert.Contains(t, out, "### GSW")
+		assert.Contains(t, out, "| PLAYER | MIN | FGM |")
+		assert.Contains(t, out, "| PTS | +/- | TS% | eFG% | AST/TO | GmSc | USG% |")
+		assert.Contains(t, out, "| **TOTAL** |")
+		assert.Contains(t, out, "| 3P% |")
+		assert.Equal(t, 2, strings.Count(out, "| :--- |"))
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		assert.Error(t, WriteBoxScore(&bytes.Buffer{}, game, FormatTable))
+	})
+}
+
+func TestBoxScoreFileName(t *testing.T) {
+	assert.Equal(t, "0022500001_boxscore.md", BoxScoreFileName("0022500001", FormatMarkdown))
+}
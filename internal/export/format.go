@@ -0,0 +1,27 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatTable  Format = "table"
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+)
+
+// ParseFormat validates s against the formats a command supports.
+func ParseFormat(s string, supported ...Format) (Format, error) {
+	f := Format(strings.ToLower(s))
+	names := make([]string, 0, len(supported))
+	for _, sf := range supported {
+		if f == sf {
+			return f, nil
+		}
+		names = append(names, string(sf))
+	}
+	return "", fmt.Errorf("unsupported format %q (%s)", s, strings.Join(names, "|"))
+}
@@ -0,0 +1,96 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"nba-tui/internal/utils"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+type TeamScore struct {
+	TeamID  int    `json:"teamId"`
+	Tricode string `json:"tricode"`
+	Name    string `json:"name"`
+	Score   int    `json:"score"`
+}
+
+// GameSummary is the scoreboard row shared by the json and ndjson formats.
+type GameSummary struct {
+	GameID     string    `json:"gameId"`
+	Status     string    `json:"status"`
+	GameStatus int       `json:"gameStatus"`
+	Period     int       `json:"period"`
+	Home       TeamScore `json:"home"`
+	Away       TeamScore `json:"away"`
+}
+
+func NewGameSummary(game types.Game) GameSummary {
+	return GameSummary{
+		GameID:     game.GameId,
+		Status:     utils.RenderGameStatus(game),
+		GameStatus: game.GameStatus,
+		Period:     game.Period,
+		Home:       newTeamScore(game.HomeTeam),
+		Away:       newTeamScore(game.AwayTeam),
+	}
+}
+
+func newTeamScore(team types.Team) TeamScore {
+	return TeamScore{
+		TeamID:  team.TeamId,
+		Tricode: team.TeamTricode,
+		Name:    team.TeamName,
+		Score:   team.Score,
+	}
+}
+
+func WriteScoreboard(w io.Writer, games []types.Game, format Format) error {
+	summaries := make([]GameSummary, 0, len(games))
+	for _, game := range games {
+		summaries = append(summaries, NewGameSummary(game))
+	}
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(summaries)
+	case FormatNDJSON:
+		enc := json.NewEncoder(w)
+		for _, s := range summaries {
+			if err := enc.Encode(s); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatTable:
+		return writeScoreboardTable(w, games)
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// writeScoreboardTable mirrors the scoreboard cards of the TUI, one game per line.
+func writeScoreboardTable(w io.Writer, games []types.Game) error {
+	if len(games) == 0 {
+		_, err := fmt.Fprintln(w, "No games scheduled.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "GAME\tSTATUS\tHOME\tPTS\tAWAY\tPTS")
+	for _, game := range games {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			game.GameId,
+			utils.RenderGameStatus(game),
+			game.HomeTeam.TeamTricode, strings.TrimRight(utils.FormatScore(game.HomeTeam.Score), " "),
+			game.AwayTeam.TeamTricode, strings.TrimRight(utils.FormatScore(game.AwayTeam.Score), " "),
+		)
+	}
+	return tw.Flush()
+}
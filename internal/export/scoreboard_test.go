@@ -0,0 +1,85 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testGames = []types.Game{
+	{
+		GameId:     "0022500001",
+		GameStatus: 3,
+		HomeTeam:   types.Team{TeamId: 1, TeamTricode: "POR", TeamName: "Trail Blazers", Score: 103},
+		AwayTeam:   types.Team{TeamId: 2, TeamTricode: "DEN", TeamName: "Nuggets", Score: 102},
+	},
+	{
+		GameId:     "0022500002",
+		GameStatus: 1,
+		HomeTeam:   types.Team{TeamTricode: "LAL"},
+		AwayTeam:   types.Team{TeamTricode: "GSW"},
+	},
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("JSON", FormatTable, FormatJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, FormatJSON, f)
+
+	_, err = ParseFormat("xml", FormatTable, FormatJSON)
+	assert.EqualError(t, err, `unsupported format "xml" (table|json)`)
+}
+
+func TestWriteScoreboard(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := WriteScoreboard(&buf, testGames, FormatTable)
+		assert.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 3)
+		assert.Contains(t, lines[0], "STATUS")
+		assert.Regexp(t, `0022500001\s+Final\s+POR\s+103\s+DEN\s+102`, lines[1])
+		assert.Regexp(t, `0022500002\s+Not Started\s+LAL\s+0\s+GSW\s+0`, lines[2])
+	})
+
+	t.Run("table without games", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, WriteScoreboard(&buf, nil, FormatTable))
+		assert.Equal(t, "No games scheduled.\n", buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := WriteScoreboard(&buf, testGames, FormatJSON)
+		assert.NoError(t, err)
+
+		var got []GameSummary
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Len(t, got, 2)
+		assert.Equal(t, "Final", got[0].Status)
+		assert.Equal(t, "POR", got[0].Home.Tricode)
+		assert.Equal(t, 102, got[0].Away.Score)
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := WriteScoreboard(&buf, testGames, FormatNDJSON)
+		assert.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 2)
+		var got GameSummary
+		assert.NoError(t, json.Unmarshal([]byte(lines[1]), &got))
+		assert.Equal(t, "0022500002", got.GameID)
+		assert.Equal(t, "Not Started", got.Status)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		assert.Error(t, WriteScoreboard(&bytes.Buffer{}, testGames, Format("csv")))
+	})
+}
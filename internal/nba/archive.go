@@ -0,0 +1,86 @@
+package nba
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// DataClient is the set of fetches the TUI performs against the NBA feeds.
+type DataClient interface {
+	GetScoreboard() ([]types.Game, error)
+	GetScoreboardByDate(date time.Time) ([]types.Game, error)
+	GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error)
+	GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error)
+}
+
+const (
+	kindScoreboard = "scoreboard"
+	kindBoxScore   = "boxscore"
+	kindPlayByPlay = "playbyplay"
+
+	archiveFileTimeFmt = "20060102T150405.000000000Z"
+)
+
+// archiveEntry is a single recorded response stored as one JSON file.
+type archiveEntry struct {
+	RecordedAt time.Time       `json:"recordedAt"`
+	Kind       string          `json:"kind"`
+	Key        string          `json:"key,omitempty"` // game id or scoreboard date
+	Payload    json.RawMessage `json:"payload,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+func scoreboardKey(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format(gameDateFmt)
+}
+
+func archiveFileName(entry archiveEntry, seq int) string {
+	name := fmt.Sprintf("%s-%06d-%s", entry.RecordedAt.UTC().Format(archiveFileTimeFmt), seq, entry.Kind)
+	if entry.Key != "" {
+		name += "-" + entry.Key
+	}
+	return name + ".json"
+}
+
+func writeArchiveEntry(dir string, entry archiveEntry, seq int) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, archiveFileName(entry, seq)), data, 0o600)
+}
+
+// readArchive loads every entry in dir ordered by recording time.
+func readArchive(dir string) ([]archiveEntry, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]archiveEntry, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path) // #nosec G304 -- path comes from the archive directory
+		if err != nil {
+			return nil, err
+		}
+		var entry archiveEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].RecordedAt.Before(entries[j].RecordedAt)
+	})
+	return entries, nil
+}
@@ -0,0 +1,124 @@
+package nba
+
+import (
+	"sync"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// CachingClient shares the fetches of the wrapped client between its
+// callers. A response is reused for ttl, and callers asking for the same
+// data while it is being fetched wait for that one request. Failed fetches
+// are not cached and expired responses are dropped, so games nobody asks
+// for anymore are not kept. Responses are shared, so callers must not
+// modify them.
+type CachingClient struct {
+	client  DataClient
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	swept   time.Time // when expired entries were last dropped
+}
+
+type cacheEntry struct {
+	done      chan struct{} // closed once the fetch is over
+	fetchedAt time.Time
+	value     any
+	err       error
+}
+
+func NewCachingClient(client DataClient, ttl time.Duration) *CachingClient {
+	return &CachingClient{
+		client:  client,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+func (c *CachingClient) GetScoreboard() ([]types.Game, error) {
+	v, err := c.get(kindScoreboard, func() (any, error) {
+		return c.client.GetScoreboard()
+	})
+	games, _ := v.([]types.Game)
+	return games, err
+}
+
+func (c *CachingClient) GetScoreboardByDate(date time.Time) ([]types.Game, error) {
+	v, err := c.get(kindScoreboard+"/"+scoreboardKey(date), func() (any, error) {
+		return c.client.GetScoreboardByDate(date)
+	})
+	games, _ := v.([]types.Game)
+	return games, err
+}
+
+func (c *CachingClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error) {
+	v, err := c.get(kindBoxScore+"/"+gameID, func() (any, error) {
+		return c.client.GetBoxScore(gameID)
+	})
+	res, _ := v.(types.LiveBoxScoreResponse)
+	return res, err
+}
+
+func (c *CachingClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error) {
+	v, err := c.get(kindPlayByPlay+"/"+gameID, func() (any, error) {
+		return c.client.GetPlayByPlay(gameID)
+	})
+	res, _ := v.(types.LivePlayByPlayResponse)
+	return res, err
+}
+
+// get returns the cached value of key, fetching it when it is missing or
+// older than the ttl.
+func (c *CachingClient) get(key string, fetch func() (any, error)) (any, error) {
+	c.mu.Lock()
+	c.sweep()
+	if e, ok := c.entries[key]; ok {
+		select {
+		case <-e.done:
+			if c.now().Sub(e.fetchedAt) < c.ttl {
+				c.mu.Unlock()
+				return e.value, nil
+			}
+		default:
+			c.mu.Unlock()
+			<-e.done
+			return e.value, e.err
+		}
+	}
+	e := &cacheEntry{done: make(chan struct{})}
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	e.value, e.err = fetch()
+
+	c.mu.Lock()
+	e.fetchedAt = c.now()
+	if e.err != nil {
+		delete(c.entries, key)
+	}
+	close(e.done)
+	c.mu.Unlock()
+	return e.value, e.err
+}
+
+// sweep drops the expired entries, at most once a ttl. c.mu must be held.
+func (c *CachingClient) sweep() {
+	now := c.now()
+	if now.Sub(c.swept) < c.ttl {
+		return
+	}
+	c.swept = now
+	for key, e := range c.entries {
+		select {
+		case <-e.done:
+			if now.Sub(e.fetchedAt) >= c.ttl {
+				delete(c.entries, key)
+			}
+		default:
+			// Still being fetched
+		}
+	}
+}
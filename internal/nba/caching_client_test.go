@@ -0,0 +1,131 @@
+package nba
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"nba-tui/internal/ui/root"
+)
+
+// countingClient counts the fetches and can hold them until released.
+type countingClient struct {
+	MockClient
+	calls   atomic.Int32
+	release chan struct{}
+	err     error
+}
+
+func (c *countingClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error) {
+	n := c.calls.Add(1)
+	if c.release != nil {
+		<-c.release
+	}
+	if c.err != nil {
+		return types.LiveBoxScoreResponse{}, c.err
+	}
+	return types.LiveBoxScoreResponse{Game: types.Game{GameId: gameID, Period: int(n)}}, nil
+}
+
+func TestCachingClient_Interface(t *testing.T) {
+	var _ root.Client = (*CachingClient)(nil)
+}
+
+func TestCachingClient_ReusesUntilExpired(t *testing.T) {
+	upstream := &countingClient{}
+	client := NewCachingClient(upstream, 10*time.Second)
+	clock := time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)
+	client.now = func() time.Time { return clock }
+
+	res, err := client.GetBoxScore("1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, res.Game.Period)
+
+	clock = clock.Add(9 * time.Second)
+	res, _ = client.GetBoxScore("1")
+	assert.Equal(t, 1, res.Game.Period)
+
+	// Another game is another entry
+	res, _ = client.GetBoxScore("2")
+	assert.Equal(t, "2", res.Game.GameId)
+
+	clock = clock.Add(time.Second)
+	res, _ = client.GetBoxScore("1")
+	assert.Equal(t, 3, res.Game.Period)
+	assert.Equal(t, int32(3), upstream.calls.Load())
+}
+
+func TestCachingClient_SharesInFlightFetch(t *testing.T) {
+	upstream := &countingClient{release: make(chan struct{})}
+	client := NewCachingClient(upstream, time.Minute)
+
+	var wg sync.WaitGroup
+	results := make([]types.LiveBoxScoreResponse, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = client.GetBoxScore("1")
+		}()
+	}
+	// Let every caller reach the cache before the fetch finishes
+	assert.Eventually(t, func() bool { return upstream.calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(upstream.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), upstream.calls.Load())
+	for _, res := range results {
+		assert.Equal(t, "1", res.Game.GameId)
+	}
+}
+
+func TestCachingClient_DoesNotCacheErrors(t *testing.T) {
+	upstream := &countingClient{err: errors.New("timeout")}
+	client := NewCachingClient(upstream, time.Minute)
+
+	_, err := client.GetBoxScore("1")
+	assert.ErrorContains(t, err, "timeout")
+
+	upstream.err = nil
+	res, err := client.GetBoxScore("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", res.Game.GameId)
+	assert.Equal(t, int32(2), upstream.calls.Load())
+}
+
+func TestCachingClient_Scoreboards(t *testing.T) {
+	client := NewCachingClient(NewMockClient(), time.Minute)
+	today, err := client.GetScoreboard()
+	assert.NoError(t, err)
+	assert.Len(t, today, 2)
+
+	_, err = client.GetScoreboardByDate(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local))
+	assert.NoError(t, err)
+	assert.Len(t, client.entries, 2)
+}
+
+func TestCachingClient_DropsExpiredEntries(t *testing.T) {
+	client := NewCachingClient(&countingClient{}, 10*time.Second)
+	clock := time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)
+	client.now = func() time.Time { return clock }
+
+	_, _ = client.GetBoxScore("1")
+	clock = clock.Add(5 * time.Second)
+	_, _ = client.GetBoxScore("2")
+	assert.Len(t, client.entries, 2)
+
+	// Game 1 is no longer asked for
+	clock = clock.Add(6 * time.Second)
+	_, _ = client.GetBoxScore("3")
+	assert.Len(t, client.entries, 2)
+	assert.NotContains(t, client.entries, kindBoxScore+"/1")
+
+	clock = clock.Add(10 * time.Second)
+	_, _ = client.GetBoxScore("3")
+	assert.Len(t, client.entries, 1)
+}
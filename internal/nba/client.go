@@ -1,17 +1,32 @@
 package nba
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
 	"github.com/poteto0/go-nba-sdk/gns"
 	"github.com/poteto0/go-nba-sdk/types"
 )
 
+const (
+	statsBaseURL = "https://stats.nba.com/stats"
+	gameDateFmt  = "2006-01-02"
+)
+
 type Client struct {
-	gnsClient *gns.Client
+	gnsClient    *gns.Client
+	httpClient   *http.Client
+	statsBaseURL string
 }
 
 func NewClient() *Client {
 	return &Client{
-		gnsClient: gns.NewClient(nil),
+		gnsClient:    gns.NewClient(nil),
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		statsBaseURL: statsBaseURL,
 	}
 }
 
@@ -38,3 +53,42 @@ func (c *Client) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, err
 	}
 	return result.Contents, nil
 }
+
+// GetScoreboardByDate returns the slate of the given day.
+// The live feed only knows today's games, so past and future dates are
+// fetched from the stats scoreboard which shares the same game schema.
+func (c *Client) GetScoreboardByDate(date time.Time) ([]types.Game, error) {
+	params := url.Values{}
+	params.Set("GameDate", date.Format(gameDateFmt))
+	params.Set("LeagueID", "00")
+
+	req, err := http.NewRequest(http.MethodGet, c.statsBaseURL+"/scoreboardv3?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	// stats.nba.com rejects requests without browser-like headers
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+	req.Header.Set("Referer", "https://www.nba.com/")
+	req.Header.Set("Origin", "https://www.nba.com")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scoreboard for %s: unexpected status %d", date.Format(gameDateFmt), res.StatusCode)
+	}
+
+	var body struct {
+		Scoreboard struct {
+			Games []types.Game `json:"games"`
+		} `json:"scoreboard"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return body.Scoreboard.Games, nil
+}
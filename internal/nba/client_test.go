@@ -1,7 +1,10 @@
 package nba
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -69,3 +72,44 @@ func TestClient_GetPlayByPlay(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestClient_GetScoreboardByDate(t *testing.T) {
+	t.Run("decode games of requested date", func(t *testing.T) {
+		// Arrange
+		var gotDate string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotDate = r.URL.Query().Get("GameDate")
+			_, _ = w.Write([]byte(`{"scoreboard":{"games":[{"gameId":"0022500001","homeTeam":{"teamTricode":"LAL","score":101},"awayTeam":{"teamTricode":"GSW","score":99}}]}}`))
+		}))
+		defer server.Close()
+		c := NewClient()
+		c.statsBaseURL = server.URL
+
+		// Act
+		result, err := c.GetScoreboardByDate(time.Date(2025, 12, 25, 0, 0, 0, 0, time.Local))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "2025-12-25", gotDate)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "0022500001", result[0].GameId)
+		assert.Equal(t, "LAL", result[0].HomeTeam.TeamTricode)
+		assert.Equal(t, 101, result[0].HomeTeam.Score)
+	})
+
+	t.Run("non 200 status is error", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+		c := NewClient()
+		c.statsBaseURL = server.URL
+
+		// Act
+		_, err := c.GetScoreboardByDate(time.Now())
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
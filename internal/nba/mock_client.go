@@ -1,6 +1,8 @@
 package nba
 
 import (
+	"time"
+
 	"github.com/poteto0/go-nba-sdk/types"
 )
 
@@ -47,6 +49,10 @@ func (c *MockClient) GetScoreboard() ([]types.Game, error) {
 	}, nil
 }
 
+func (c *MockClient) GetScoreboardByDate(_ time.Time) ([]types.Game, error) {
+	return c.GetScoreboard()
+}
+
 func (c *MockClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error) {
 	min := "PT35M00.00S"
 	pts := 30
@@ -61,6 +67,7 @@ func (c *MockClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, err
 			FirstName:  "LeBron",
 			FamilyName: "James",
 			PersonID:   2544,
+			Starter:    "1",
 			Statistics: &types.PlayerBoxScoreStatistic{
 				CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
 					Minutes: min,
@@ -93,6 +100,7 @@ func (c *MockClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, err
 			FirstName:  "Stephen",
 			FamilyName: "Curry",
 			PersonID:   201939,
+			Starter:    "1",
 			Statistics: &types.PlayerBoxScoreStatistic{
 				CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
 					Minutes: "PT34M00.00S",
@@ -109,7 +117,7 @@ func (c *MockClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, err
 		Game: types.Game{
 			GameId: gameID,
 			HomeTeam: types.Team{
-				TeamId:      1,
+				TeamId:      1610612747,
 				TeamTricode: "LAL",
 				Score:       110,
 				Players:     &homePlayers,
@@ -123,7 +131,7 @@ func (c *MockClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, err
 				},
 			},
 			AwayTeam: types.Team{
-				TeamId:      2,
+				TeamId:      1610612744,
 				TeamTricode: "GSW",
 				Score:       100,
 				Players:     &awayPlayers,
@@ -150,6 +158,8 @@ func (c *MockClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse,
 					Clock:        "11:00",
 					Period:       1,
 					TeamID:       1610612747, // LAL
+					ScoreHome:    "0",
+					ScoreAway:    "0",
 					Description:  "Jump Ball James vs Curry",
 				},
 				{
@@ -157,6 +167,14 @@ func (c *MockClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse,
 					Clock:        "10:45",
 					Period:       1,
 					TeamID:       1610612747, // LAL
+					PersonID:     2544,
+					ActionType:   "2pt",
+					IsFieldGoal:  1,
+					ShotResult:   "Made",
+					X:            8.5,
+					Y:            55,
+					ScoreHome:    "2",
+					ScoreAway:    "0",
 					Description:  "James 2pt Shot Made",
 				},
 				{
@@ -164,6 +182,14 @@ func (c *MockClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse,
 					Clock:        "10:30",
 					Period:       1,
 					TeamID:       1610612744, // GSW
+					PersonID:     201939,
+					ActionType:   "3pt",
+					IsFieldGoal:  1,
+					ShotResult:   "Missed",
+					X:            70,
+					Y:            30,
+					ScoreHome:    "2",
+					ScoreAway:    "0",
 					Description:  "Curry 3pt Shot Missed",
 				},
 			},
@@ -0,0 +1,89 @@
+package nba
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// RecordingClient passes every fetch through to the wrapped client and
+// archives the response in dir so it can be replayed by ReplayClient.
+type RecordingClient struct {
+	client DataClient
+	dir    string
+	now    func() time.Time
+	mu     sync.Mutex
+	seq    int
+}
+
+func NewRecordingClient(client DataClient, dir string) (*RecordingClient, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &RecordingClient{
+		client: client,
+		dir:    dir,
+		now:    time.Now,
+	}, nil
+}
+
+func (c *RecordingClient) GetScoreboard() ([]types.Game, error) {
+	games, err := c.client.GetScoreboard()
+	if recErr := c.record(kindScoreboard, "", games, err); recErr != nil {
+		return nil, recErr
+	}
+	return games, err
+}
+
+func (c *RecordingClient) GetScoreboardByDate(date time.Time) ([]types.Game, error) {
+	games, err := c.client.GetScoreboardByDate(date)
+	if recErr := c.record(kindScoreboard, scoreboardKey(date), games, err); recErr != nil {
+		return nil, recErr
+	}
+	return games, err
+}
+
+func (c *RecordingClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error) {
+	res, err := c.client.GetBoxScore(gameID)
+	if recErr := c.record(kindBoxScore, gameID, res, err); recErr != nil {
+		return types.LiveBoxScoreResponse{}, recErr
+	}
+	return res, err
+}
+
+func (c *RecordingClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error) {
+	res, err := c.client.GetPlayByPlay(gameID)
+	if recErr := c.record(kindPlayByPlay, gameID, res, err); recErr != nil {
+		return types.LivePlayByPlayResponse{}, recErr
+	}
+	return res, err
+}
+
+func (c *RecordingClient) record(kind, key string, payload any, fetchErr error) error {
+	entry := archiveEntry{
+		RecordedAt: c.now(),
+		Kind:       kind,
+		Key:        key,
+	}
+	if fetchErr != nil {
+		entry.Error = fetchErr.Error()
+	} else {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("record %s: %w", kind, err)
+		}
+		entry.Payload = data
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	if err := writeArchiveEntry(c.dir, entry, c.seq); err != nil {
+		return fmt.Errorf("record %s: %w", kind, err)
+	}
+	return nil
+}
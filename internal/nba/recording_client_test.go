@@ -0,0 +1,48 @@
+package nba
+
+import (
+	"os"
+	"testing"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"nba-tui/internal/ui/root"
+)
+
+type scriptedClient struct {
+	MockClient
+	score int
+	err   error
+}
+
+func (c *scriptedClient) GetScoreboard() ([]types.Game, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return []types.Game{{GameId: "1", HomeTeam: types.Team{Score: c.score}}}, nil
+}
+
+func TestRecordingClient_Interface(t *testing.T) {
+	var _ root.Client = (*RecordingClient)(nil)
+	var _ root.Client = (*ReplayClient)(nil)
+}
+
+func TestRecordingClient_WritesArchive(t *testing.T) {
+	dir := t.TempDir()
+	client, err := NewRecordingClient(NewMockClient(), dir)
+	assert.NoError(t, err)
+
+	games, err := client.GetScoreboard()
+	assert.NoError(t, err)
+	assert.Len(t, games, 2)
+
+	_, err = client.GetBoxScore("0012300001")
+	assert.NoError(t, err)
+	_, err = client.GetPlayByPlay("0012300001")
+	assert.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 3)
+	assert.Contains(t, files[1].Name(), "boxscore-0012300001")
+}
@@ -0,0 +1,114 @@
+package nba
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// ReplayClient serves responses archived by RecordingClient.
+// The recording timeline advances with the wall clock multiplied by speed,
+// and each fetch returns the latest response recorded up to that point.
+type ReplayClient struct {
+	entries   map[string][]archiveEntry // by kind and key, oldest first
+	origin    time.Time                 // first recorded timestamp
+	startedAt time.Time
+	speed     float64
+	now       func() time.Time
+}
+
+func NewReplayClient(dir string, speed float64) (*ReplayClient, error) {
+	archive, err := readArchive(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(archive) == 0 {
+		return nil, fmt.Errorf("no recorded responses in %s", dir)
+	}
+	if speed <= 0 {
+		speed = 1
+	}
+
+	entries := make(map[string][]archiveEntry)
+	for _, entry := range archive {
+		k := replayKey(entry.Kind, entry.Key)
+		entries[k] = append(entries[k], entry)
+	}
+
+	return &ReplayClient{
+		entries:   entries,
+		origin:    archive[0].RecordedAt,
+		startedAt: time.Now(),
+		speed:     speed,
+		now:       time.Now,
+	}, nil
+}
+
+func replayKey(kind, key string) string {
+	return kind + "/" + key
+}
+
+// position returns the point of the recording currently being replayed.
+func (c *ReplayClient) position() time.Time {
+	elapsed := float64(c.now().Sub(c.startedAt)) * c.speed
+	return c.origin.Add(time.Duration(elapsed))
+}
+
+// lookup decodes the latest response recorded for kind and key into out.
+// Before the first recording of that key is reached, the first one is used
+// so views opened early in the replay still have data.
+func (c *ReplayClient) lookup(kind, key string, out any) error {
+	recorded := c.entries[replayKey(kind, key)]
+	if len(recorded) == 0 {
+		return fmt.Errorf("replay: no recorded %s for %q", kind, key)
+	}
+
+	pos := c.position()
+	entry := recorded[0]
+	for _, e := range recorded[1:] {
+		if e.RecordedAt.After(pos) {
+			break
+		}
+		entry = e
+	}
+
+	if entry.Error != "" {
+		return errors.New(entry.Error)
+	}
+	return json.Unmarshal(entry.Payload, out)
+}
+
+func (c *ReplayClient) GetScoreboard() ([]types.Game, error) {
+	var games []types.Game
+	if err := c.lookup(kindScoreboard, "", &games); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+func (c *ReplayClient) GetScoreboardByDate(date time.Time) ([]types.Game, error) {
+	var games []types.Game
+	if err := c.lookup(kindScoreboard, scoreboardKey(date), &games); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+func (c *ReplayClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error) {
+	var res types.LiveBoxScoreResponse
+	if err := c.lookup(kindBoxScore, gameID, &res); err != nil {
+		return types.LiveBoxScoreResponse{}, err
+	}
+	return res, nil
+}
+
+func (c *ReplayClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error) {
+	var res types.LivePlayByPlayResponse
+	if err := c.lookup(kindPlayByPlay, gameID, &res); err != nil {
+		return types.LivePlayByPlayResponse{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,78 @@
+package nba
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayClient_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := NewRecordingClient(NewMockClient(), dir)
+	assert.NoError(t, err)
+	want, _ := recorder.GetBoxScore("0012300001")
+	wantPbp, _ := recorder.GetPlayByPlay("0012300001")
+
+	replay, err := NewReplayClient(dir, 1)
+	assert.NoError(t, err)
+
+	got, err := replay.GetBoxScore("0012300001")
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	gotPbp, err := replay.GetPlayByPlay("0012300001")
+	assert.NoError(t, err)
+	assert.Equal(t, wantPbp, gotPbp)
+
+	_, err = replay.GetBoxScore("unknown")
+	assert.Error(t, err)
+}
+
+func TestReplayClient_AdvancesWithSpeed(t *testing.T) {
+	dir := t.TempDir()
+	source := &scriptedClient{}
+	recorder, err := NewRecordingClient(source, dir)
+	assert.NoError(t, err)
+
+	recordedAt := time.Date(2025, 12, 25, 20, 0, 0, 0, time.UTC)
+	recorder.now = func() time.Time { return recordedAt }
+	for i, score := range []int{10, 20, 30} {
+		source.score = score
+		recordedAt = recordedAt.Add(time.Duration(i) * time.Minute)
+		_, err := recorder.GetScoreboard()
+		assert.NoError(t, err)
+	}
+	// Errors are replayed as well
+	source.err = errors.New("feed down")
+	recordedAt = recordedAt.Add(10 * time.Minute)
+	_, _ = recorder.GetScoreboard()
+
+	replay, err := NewReplayClient(dir, 60)
+	assert.NoError(t, err)
+	clock := replay.startedAt
+	replay.now = func() time.Time { return clock }
+
+	games, err := replay.GetScoreboard()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, games[0].HomeTeam.Score)
+
+	// 1 real second at 60x is one recorded minute
+	clock = clock.Add(time.Second)
+	games, _ = replay.GetScoreboard()
+	assert.Equal(t, 20, games[0].HomeTeam.Score)
+
+	clock = clock.Add(2 * time.Second)
+	games, _ = replay.GetScoreboard()
+	assert.Equal(t, 30, games[0].HomeTeam.Score)
+
+	clock = clock.Add(time.Minute)
+	_, err = replay.GetScoreboard()
+	assert.EqualError(t, err, "feed down")
+}
+
+func TestReplayClient_EmptyArchive(t *testing.T) {
+	_, err := NewReplayClient(t.TempDir(), 1)
+	assert.Error(t, err)
+}
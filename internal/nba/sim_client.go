@@ -0,0 +1,571 @@
+package nba
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+const (
+	simPeriodSeconds   = 12 * 60
+	simOverTimeSeconds = 5 * 60
+	simStepSeconds     = 60
+)
+
+type SimPlayerSpec struct {
+	PersonID   int
+	FirstName  string
+	FamilyName string
+}
+
+type SimTeamSpec struct {
+	TeamID   int
+	Tricode  string
+	Name     string
+	Players  []SimPlayerSpec
+	Strength float64 // shooting bonus added to make percentages, e.g. 0.02
+}
+
+type SimGameSpec struct {
+	GameID     string
+	Home       SimTeamSpec
+	Away       SimTeamSpec
+	StartAfter int // number of steps the game stays "Not Started"
+}
+
+// SimScenario describes the games simulated by SimClient.
+// The same seed and call sequence always produce the same games.
+type SimScenario struct {
+	Seed        int64
+	StepSeconds int // game seconds simulated per call
+	Games       []SimGameSpec
+}
+
+// SimClient is a mock whose games progress on every call, so reloads,
+// period changes, overtime and the final transition can be watched locally.
+type SimClient struct {
+	mu    sync.Mutex
+	games []*simGame
+	step  int
+}
+
+func NewSimClient(scenario SimScenario) *SimClient {
+	if scenario.StepSeconds <= 0 {
+		scenario.StepSeconds = simStepSeconds
+	}
+	c := &SimClient{step: scenario.StepSeconds}
+	for i, spec := range scenario.Games {
+		c.games = append(c.games, newSimGame(spec, rand.New(rand.NewSource(scenario.Seed+int64(i))))) // #nosec G404 -- deterministic simulation
+	}
+	return c
+}
+
+// DefaultSimScenario is used by --mock=sim.
+func DefaultSimScenario() SimScenario {
+	return SimScenario{
+		Seed:        20240101,
+		StepSeconds: simStepSeconds,
+		Games: []SimGameSpec{
+			{
+				GameID: "0012300001",
+				Home: SimTeamSpec{TeamID: 1610612747, Tricode: "LAL", Name: "Lakers", Strength: 0.01, Players: []SimPlayerSpec{
+					{2544, "LeBron", "James"}, {203076, "Anthony", "Davis"}, {1629029, "Luka", "Doncic"},
+					{1630559, "Austin", "Reaves"}, {1627936, "Gabe", "Vincent"}, {1626156, "D'Angelo", "Russell"},
+					{1629216, "Jaxson", "Hayes"}, {1630692, "Max", "Christie"},
+				}},
+				Away: SimTeamSpec{TeamID: 1610612744, Tricode: "GSW", Name: "Warriors", Players: []SimPlayerSpec{
+					{201939, "Stephen", "Curry"}, {203110, "Draymond", "Green"}, {1626172, "Kevon", "Looney"},
+					{1630228, "Jonathan", "Kuminga"}, {1630541, "Moses", "Moody"}, {202691, "Klay", "Thompson"},
+					{1641764, "Brandin", "Podziemski"}, {1631218, "Trayce", "Jackson-Davis"},
+				}},
+			},
+			{
+				GameID:     "0012300002",
+				StartAfter: 3,
+				Home: SimTeamSpec{TeamID: 1610612738, Tricode: "BOS", Name: "Celtics", Strength: 0.02, Players: []SimPlayerSpec{
+					{1628369, "Jayson", "Tatum"}, {1627759, "Jaylen", "Brown"}, {1628401, "Derrick", "White"},
+					{201950, "Jrue", "Holiday"}, {1629684, "Kristaps", "Porzingis"}, {1628464, "Al", "Horford"},
+					{1630202, "Payton", "Pritchard"}, {1630573, "Sam", "Hauser"},
+				}},
+				Away: SimTeamSpec{TeamID: 1610612748, Tricode: "MIA", Name: "Heat", Players: []SimPlayerSpec{
+					{202710, "Jimmy", "Butler"}, {1628389, "Bam", "Adebayo"}, {1629639, "Tyler", "Herro"},
+					{1627741, "Terry", "Rozier"}, {1629130, "Duncan", "Robinson"}, {1631107, "Jaime", "Jaquez"},
+					{1628960, "Kevin", "Love"}, {1629312, "Haywood", "Highsmith"},
+				}},
+			},
+		},
+	}
+}
+
+func (c *SimClient) GetScoreboard() ([]types.Game, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	games := make([]types.Game, 0, len(c.games))
+	for _, g := range c.games {
+		g.advance(c.step)
+		games = append(games, g.game(false))
+	}
+	return games, nil
+}
+
+func (c *SimClient) GetScoreboardByDate(_ time.Time) ([]types.Game, error) {
+	// Only today's slate is simulated
+	return []types.Game{}, nil
+}
+
+func (c *SimClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, err := c.find(gameID)
+	if err != nil {
+		return types.LiveBoxScoreResponse{}, err
+	}
+	g.advance(c.step)
+	return types.LiveBoxScoreResponse{Game: g.game(true)}, nil
+}
+
+func (c *SimClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, err := c.find(gameID)
+	if err != nil {
+		return types.LivePlayByPlayResponse{}, err
+	}
+	g.advance(c.step)
+
+	actions := make([]types.Action, len(g.actions))
+	copy(actions, g.actions)
+	return types.LivePlayByPlayResponse{
+		Game: types.PlayByPlayGame{
+			GameID:  gameID,
+			Actions: actions,
+		},
+	}, nil
+}
+
+func (c *SimClient) find(gameID string) (*simGame, error) {
+	for _, g := range c.games {
+		if g.spec.GameID == gameID {
+			return g, nil
+		}
+	}
+	return nil, fmt.Errorf("sim: unknown game %s", gameID)
+}
+
+type simPlayerStats struct {
+	seconds                      int
+	pts, fgm, fga, fg3m, fg3a    int
+	ftm, fta, oreb, dreb, ast    int
+	stl, blk, tov, pf, plusMinus int
+}
+
+type simTeam struct {
+	spec    SimTeamSpec
+	score   int
+	periods []int // points per period
+	stats   []simPlayerStats
+}
+
+type simGame struct {
+	spec      SimGameSpec
+	rng       *rand.Rand
+	status    int // 1: not started, 2: live, 3: final
+	period    int
+	clock     int // seconds remaining in the period
+	home      simTeam
+	away      simTeam
+	homeBall  bool
+	waitSteps int
+	actions   []types.Action
+}
+
+func newSimGame(spec SimGameSpec, rng *rand.Rand) *simGame {
+	return &simGame{
+		spec:      spec,
+		rng:       rng,
+		status:    1,
+		home:      simTeam{spec: spec.Home, stats: make([]simPlayerStats, len(spec.Home.Players))},
+		away:      simTeam{spec: spec.Away, stats: make([]simPlayerStats, len(spec.Away.Players))},
+		waitSteps: spec.StartAfter,
+	}
+}
+
+// lineup returns the indices of the five players on the floor.
+// The rotation shifts every period so bench players log minutes too.
+func (g *simGame) lineup(t *simTeam) []int {
+	n := len(t.spec.Players)
+	if n <= 5 {
+		idx := make([]int, n)
+		for i := range idx {
+			idx[i] = i
+		}
+		return idx
+	}
+	shift := 0
+	if g.period%2 == 0 {
+		shift = n - 5
+	}
+	idx := make([]int, 5)
+	for i := range idx {
+		idx[i] = (i + shift) % n
+	}
+	return idx
+}
+
+func (g *simGame) advance(seconds int) {
+	switch g.status {
+	case 1:
+		if g.waitSteps > 0 {
+			g.waitSteps--
+			return
+		}
+		g.status = 2
+		g.period = 1
+		g.clock = simPeriodSeconds
+		g.homeBall = g.rng.Intn(2) == 0
+		g.addAction(g.spec.Home.TeamID, "jumpball", fmt.Sprintf("Jump Ball %s vs. %s", g.name(&g.home, 0), g.name(&g.away, 0)))
+	case 2:
+		for seconds > 0 && g.status == 2 {
+			used := g.possession(seconds)
+			seconds -= used
+			if g.clock == 0 {
+				g.endPeriod()
+			}
+		}
+	}
+}
+
+func (g *simGame) endPeriod() {
+	g.addAction(0, "period", fmt.Sprintf("Period End: %s %d - %s %d", g.spec.Home.Tricode, g.home.score, g.spec.Away.Tricode, g.away.score))
+	if g.period >= 4 && g.home.score != g.away.score {
+		g.status = 3
+		g.addAction(0, "game", "Game End")
+		return
+	}
+	g.period++
+	g.clock = simPeriodSeconds
+	if g.period > 4 {
+		g.clock = simOverTimeSeconds
+	}
+	g.addAction(0, "period", fmt.Sprintf("Period Start: %s", periodName(g.period)))
+}
+
+func periodName(period int) string {
+	if period > 4 {
+		return fmt.Sprintf("OT%d", period-4)
+	}
+	return fmt.Sprintf("Q%d", period)
+}
+
+// possession simulates one trip down the floor and returns the game seconds used.
+func (g *simGame) possession(budget int) int {
+	used := 8 + g.rng.Intn(17)
+	if used > g.clock {
+		used = g.clock
+	}
+	if used > budget {
+		used = budget
+	}
+	g.clock -= used
+
+	offense, defense := &g.home, &g.away
+	if !g.homeBall {
+		offense, defense = defense, offense
+	}
+	for _, t := range []*simTeam{offense, defense} {
+		for _, i := range g.lineup(t) {
+			t.stats[i].seconds += used
+		}
+	}
+
+	onFloor := g.lineup(offense)
+	shooter := onFloor[g.rng.Intn(len(onFloor))]
+	defenders := g.lineup(defense)
+	defender := defenders[g.rng.Intn(len(defenders))]
+
+	switch roll := g.rng.Float64(); {
+	case roll < 0.13:
+		offense.stats[shooter].tov++
+		desc := fmt.Sprintf("%s Turnover", g.name(offense, shooter))
+		if g.rng.Intn(2) == 0 {
+			defense.stats[defender].stl++
+			desc += fmt.Sprintf(" (%s STEAL)", g.name(defense, defender))
+		}
+		g.addPlayerAction(offense, shooter, "turnover", desc)
+	case roll < 0.23:
+		defense.stats[defender].pf++
+		g.addPlayerAction(defense, defender, "foul", fmt.Sprintf("%s Shooting Foul", g.name(defense, defender)))
+		for n := 1; n <= 2; n++ {
+			offense.stats[shooter].fta++
+			if g.rng.Float64() < 0.78 {
+				offense.stats[shooter].ftm++
+				g.score(offense, defense, shooter, 1)
+				g.addPlayerAction(offense, shooter, "freethrow", fmt.Sprintf("%s Free Throw %d of 2 (%d PTS)", g.name(offense, shooter), n, offense.stats[shooter].pts))
+			} else {
+				g.addPlayerAction(offense, shooter, "freethrow", fmt.Sprintf("MISS %s Free Throw %d of 2", g.name(offense, shooter), n))
+			}
+		}
+	default:
+		three := g.rng.Float64() < 0.38
+		pct, pts, kind := 0.52, 2, "2pt Shot"
+		if three {
+			pct, pts, kind = 0.36, 3, "3pt Shot"
+		}
+		pct += offense.spec.Strength
+
+		offense.stats[shooter].fga++
+		if three {
+			offense.stats[shooter].fg3a++
+		}
+
+		if g.rng.Float64() < pct {
+			offense.stats[shooter].fgm++
+			if three {
+				offense.stats[shooter].fg3m++
+			}
+			g.score(offense, defense, shooter, pts)
+			desc := fmt.Sprintf("%s %s Made (%d PTS)", g.name(offense, shooter), kind, offense.stats[shooter].pts)
+			if g.rng.Float64() < 0.6 {
+				assister := onFloor[g.rng.Intn(len(onFloor))]
+				if assister != shooter {
+					offense.stats[assister].ast++
+					desc += fmt.Sprintf(" (%s %d AST)", g.name(offense, assister), offense.stats[assister].ast)
+				}
+			}
+			g.addShot(offense, shooter, three, true, desc)
+		} else {
+			desc := fmt.Sprintf("MISS %s %s", g.name(offense, shooter), kind)
+			if !three && g.rng.Float64() < 0.08 {
+				defense.stats[defender].blk++
+				desc += fmt.Sprintf(" (%s BLOCK)", g.name(defense, defender))
+			}
+			g.addShot(offense, shooter, three, false, desc)
+
+			if g.rng.Float64() < 0.25 {
+				rebounder := onFloor[g.rng.Intn(len(onFloor))]
+				offense.stats[rebounder].oreb++
+				g.addPlayerAction(offense, rebounder, "rebound", fmt.Sprintf("%s Offensive Rebound", g.name(offense, rebounder)))
+				// Keep possession for the next trip
+				return used
+			}
+			rebounder := defenders[g.rng.Intn(len(defenders))]
+			defense.stats[rebounder].dreb++
+			g.addPlayerAction(defense, rebounder, "rebound", fmt.Sprintf("%s Defensive Rebound", g.name(defense, rebounder)))
+		}
+	}
+
+	g.homeBall = !g.homeBall
+	return used
+}
+
+func (g *simGame) score(offense, defense *simTeam, shooter, pts int) {
+	offense.score += pts
+	for len(offense.periods) < g.period {
+		offense.periods = append(offense.periods, 0)
+	}
+	offense.periods[g.period-1] += pts
+	offense.stats[shooter].pts += pts
+	for _, i := range g.lineup(offense) {
+		offense.stats[i].plusMinus += pts
+	}
+	for _, i := range g.lineup(defense) {
+		defense.stats[i].plusMinus -= pts
+	}
+}
+
+func (g *simGame) name(t *simTeam, i int) string {
+	if i >= len(t.spec.Players) {
+		return t.spec.Tricode
+	}
+	return t.spec.Players[i].FamilyName
+}
+
+func (g *simGame) addAction(teamID int, actionType, desc string) {
+	g.actions = append(g.actions, types.Action{
+		ActionNumber: len(g.actions) + 1,
+		Clock:        fmt.Sprintf("%02d:%02d", g.clock/60, g.clock%60),
+		Period:       g.period,
+		TeamID:       teamID,
+		ActionType:   actionType,
+		ScoreHome:    strconv.Itoa(g.home.score),
+		ScoreAway:    strconv.Itoa(g.away.score),
+		Description:  desc,
+	})
+}
+
+// addPlayerAction records an action credited to player i of t.
+func (g *simGame) addPlayerAction(t *simTeam, i int, actionType, desc string) {
+	g.addAction(t.spec.TeamID, actionType, desc)
+	if i < len(t.spec.Players) {
+		g.actions[len(g.actions)-1].PersonID = t.spec.Players[i].PersonID
+	}
+}
+
+// addShot records a field goal attempt with a court location in the feed's
+// coordinates: x and y as percentages of the court length and width.
+func (g *simGame) addShot(t *simTeam, shooter int, three, made bool, desc string) {
+	actionType := "2pt"
+	if three {
+		actionType = "3pt"
+	}
+	g.addPlayerAction(t, shooter, actionType, desc)
+
+	// Distance from the rim in feet, then an angle facing the court
+	dist := 1 + g.rng.Float64()*19
+	if three {
+		dist = 24 + g.rng.Float64()*4
+	}
+	angle := g.rng.Float64() * math.Pi
+	across := 25 + dist*math.Cos(angle)
+	depth := 5.25 + dist*math.Sin(angle)
+	if across < 2 || across > 48 {
+		// Past the sideline: move it into the corner
+		across = math.Max(2, math.Min(48, across))
+		depth = 2 + g.rng.Float64()*10
+	}
+	// Teams switch baskets at half time
+	if (t == &g.home) == (g.period <= 2) {
+		depth = 94 - depth
+		across = 50 - across
+	}
+
+	action := &g.actions[len(g.actions)-1]
+	action.IsFieldGoal = 1
+	action.ShotResult = "Missed"
+	if made {
+		action.ShotResult = "Made"
+	}
+	action.X = depth / 94 * 100
+	action.Y = across / 50 * 100
+}
+
+func (g *simGame) statusText() string {
+	switch g.status {
+	case 1:
+		return "Not Started"
+	case 3:
+		return "Final"
+	default:
+		return fmt.Sprintf("%s %d:%02d", periodName(g.period), g.clock/60, g.clock%60)
+	}
+}
+
+// game renders the current state in the feed schema.
+// withPlayers adds the box score details only present in the box score feed.
+func (g *simGame) game(withPlayers bool) types.Game {
+	return types.Game{
+		GameId:         g.spec.GameID,
+		GameStatus:     g.status,
+		GameStatusText: g.statusText(),
+		Period:         g.period,
+		GameClock:      isoClock(g.clock),
+		HomeTeam:       g.team(&g.home, withPlayers),
+		AwayTeam:       g.team(&g.away, withPlayers),
+	}
+}
+
+func isoClock(seconds int) string {
+	return fmt.Sprintf("PT%02dM%02d.00S", seconds/60, seconds%60)
+}
+
+func (g *simGame) team(t *simTeam, withPlayers bool) types.Team {
+	team := types.Team{
+		TeamId:      t.spec.TeamID,
+		TeamName:    t.spec.Name,
+		TeamTricode: t.spec.Tricode,
+		Score:       t.score,
+	}
+	if !withPlayers {
+		return team
+	}
+
+	for p := 1; p <= g.period; p++ {
+		pts := 0
+		if p <= len(t.periods) {
+			pts = t.periods[p-1]
+		}
+		team.Periods = append(team.Periods, types.Period{Period: p, Score: pts})
+	}
+
+	var total simPlayerStats
+	players := make([]types.Player, 0, len(t.spec.Players))
+	for i, spec := range t.spec.Players {
+		s := t.stats[i]
+		total.seconds += s.seconds
+		total.pts += s.pts
+		total.fgm += s.fgm
+		total.fga += s.fga
+		total.fg3m += s.fg3m
+		total.fg3a += s.fg3a
+		total.ftm += s.ftm
+		total.fta += s.fta
+		total.oreb += s.oreb
+		total.dreb += s.dreb
+		total.ast += s.ast
+		total.stl += s.stl
+		total.blk += s.blk
+		total.tov += s.tov
+		total.pf += s.pf
+
+		pm := float64(s.plusMinus)
+		// The first lineup of the game starts
+		starter := "0"
+		if i < 5 {
+			starter = "1"
+		}
+		players = append(players, types.Player{
+			FirstName:  spec.FirstName,
+			FamilyName: spec.FamilyName,
+			PersonID:   spec.PersonID,
+			Starter:    starter,
+			Statistics: &types.PlayerBoxScoreStatistic{
+				CommonBoxScoreStatistic: s.common(),
+				PlusMinus:               &pm,
+			},
+		})
+	}
+
+	team.Players = &players
+	team.Statistics = &types.TeamBoxScoreStatistic{CommonBoxScoreStatistic: total.common()}
+	return team
+}
+
+func (s simPlayerStats) common() types.CommonBoxScoreStatistic {
+	pInt := func(i int) *int { return &i }
+	pPct := func(made, att int) *float64 {
+		pct := 0.0
+		if att > 0 {
+			pct = float64(made) / float64(att)
+		}
+		return &pct
+	}
+	return types.CommonBoxScoreStatistic{
+		Minutes: isoClock(s.seconds),
+		Pts:     pInt(s.pts),
+		Reb:     pInt(s.oreb + s.dreb),
+		Ast:     pInt(s.ast),
+		FgM:     pInt(s.fgm),
+		FgA:     pInt(s.fga),
+		FgPct:   pPct(s.fgm, s.fga),
+		Fg3M:    pInt(s.fg3m),
+		Fg3A:    pInt(s.fg3a),
+		Fg3Pct:  pPct(s.fg3m, s.fg3a),
+		FtM:     pInt(s.ftm),
+		FtA:     pInt(s.fta),
+		FtPct:   pPct(s.ftm, s.fta),
+		OReb:    pInt(s.oreb),
+		DReb:    pInt(s.dreb),
+		Stl:     pInt(s.stl),
+		Blk:     pInt(s.blk),
+		Tov:     pInt(s.tov),
+		PF:      pInt(s.pf),
+	}
+}
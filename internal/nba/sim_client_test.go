@@ -0,0 +1,135 @@
+package nba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"nba-tui/internal/ui/root"
+	"nba-tui/internal/utils"
+)
+
+func TestSimClient_Interface(t *testing.T) {
+	var _ root.Client = (*SimClient)(nil)
+}
+
+func TestSimClient_Deterministic(t *testing.T) {
+	a := NewSimClient(DefaultSimScenario())
+	b := NewSimClient(DefaultSimScenario())
+
+	for i := 0; i < 20; i++ {
+		gamesA, _ := a.GetScoreboard()
+		gamesB, _ := b.GetScoreboard()
+		assert.Equal(t, gamesA, gamesB)
+	}
+
+	pbpA, _ := a.GetPlayByPlay("0012300001")
+	pbpB, _ := b.GetPlayByPlay("0012300001")
+	assert.Equal(t, pbpA, pbpB)
+}
+
+func TestSimClient_ProgressesToFinal(t *testing.T) {
+	client := NewSimClient(DefaultSimScenario())
+
+	games, err := client.GetScoreboard()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, games[0].GameStatus)
+	assert.Equal(t, "Not Started", utils.RenderGameStatus(games[1]))
+
+	lastScore := 0
+	lastPeriod := 0
+	for i := 0; i < 200 && games[0].GameStatus != 3; i++ {
+		games, _ = client.GetScoreboard()
+		total := games[0].HomeTeam.Score + games[0].AwayTeam.Score
+		assert.GreaterOrEqual(t, total, lastScore)
+		assert.GreaterOrEqual(t, games[0].Period, lastPeriod)
+		lastScore, lastPeriod = total, games[0].Period
+	}
+
+	assert.Equal(t, "Final", utils.RenderGameStatus(games[0]))
+	assert.GreaterOrEqual(t, games[0].Period, 4)
+	assert.NotEqual(t, games[0].HomeTeam.Score, games[0].AwayTeam.Score)
+	assert.Greater(t, lastScore, 100)
+	assert.Equal(t, 2, games[1].GameStatus, "second game should have tipped off")
+}
+
+func TestSimClient_BoxScoreMatchesScore(t *testing.T) {
+	client := NewSimClient(DefaultSimScenario())
+	for i := 0; i < 10; i++ {
+		_, _ = client.GetScoreboard()
+	}
+
+	res, err := client.GetBoxScore("0012300001")
+	assert.NoError(t, err)
+
+	for _, team := range []struct {
+		score int
+		pts   *int
+		n     int
+	}{
+		{res.Game.HomeTeam.Score, res.Game.HomeTeam.Statistics.Pts, len(*res.Game.HomeTeam.Players)},
+		{res.Game.AwayTeam.Score, res.Game.AwayTeam.Statistics.Pts, len(*res.Game.AwayTeam.Players)},
+	} {
+		assert.Equal(t, team.score, *team.pts)
+		assert.Equal(t, 8, team.n)
+	}
+
+	sum := 0
+	for _, p := range *res.Game.HomeTeam.Players {
+		sum += *p.Statistics.Pts
+	}
+	assert.Equal(t, res.Game.HomeTeam.Score, sum)
+
+	periodSum := 0
+	for _, p := range res.Game.HomeTeam.Periods {
+		periodSum += p.Score
+	}
+	assert.Equal(t, res.Game.HomeTeam.Score, periodSum)
+
+	pbp, err := client.GetPlayByPlay("0012300001")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, pbp.Game.Actions)
+	assert.Equal(t, "Jump Ball James vs. Curry", pbp.Game.Actions[0].Description)
+
+	_, err = client.GetBoxScore("unknown")
+	assert.Error(t, err)
+}
+
+func TestSimClient_ShotLocations(t *testing.T) {
+	client := NewSimClient(DefaultSimScenario())
+	for i := 0; i < 20; i++ {
+		_, _ = client.GetScoreboard()
+	}
+	res, _ := client.GetBoxScore("0012300001")
+	pbp, _ := client.GetPlayByPlay("0012300001")
+
+	attempts := 0
+	for _, a := range pbp.Game.Actions {
+		if a.IsFieldGoal != 1 {
+			continue
+		}
+		if a.TeamID == res.Game.HomeTeam.TeamId {
+			attempts++
+		}
+		assert.Contains(t, []string{"Made", "Missed"}, a.ShotResult)
+		assert.NotZero(t, a.PersonID)
+		assert.True(t, a.X > 0 && a.X < 100, "x out of court: %v", a.X)
+		assert.True(t, a.Y > 0 && a.Y < 100, "y out of court: %v", a.Y)
+	}
+	assert.NotZero(t, attempts)
+}
+
+func TestSimGame_OverTime(t *testing.T) {
+	client := NewSimClient(DefaultSimScenario())
+	g := client.games[0]
+	g.advance(0) // tip off
+	g.period = 4
+	g.clock = 0
+	g.home.score, g.away.score = 100, 100
+
+	g.endPeriod()
+	game := g.game(false)
+	assert.Equal(t, 5, game.Period)
+	assert.True(t, game.IsOverTime())
+	assert.Equal(t, "PT05M00.00S", game.GameClock)
+	assert.Equal(t, "OT1 5:00", game.GameStatusText)
+}
@@ -0,0 +1,171 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Notifier delivers an event to the user.
+type Notifier interface {
+	Notify(Event) error
+}
+
+// Bell rings the terminal bell.
+type Bell struct {
+	W io.Writer
+}
+
+func (b Bell) Notify(Event) error {
+	_, err := io.WriteString(b.W, "\a")
+	return err
+}
+
+// OSC9 posts a desktop notification with the OSC 9 escape, understood by
+// iTerm2, WezTerm, Windows Terminal and others.
+type OSC9 struct {
+	W io.Writer
+}
+
+func (o OSC9) Notify(e Event) error {
+	_, err := fmt.Fprintf(o.W, "\x1b]9;%s\x07", sanitize(e.Title()+": "+e.Message()))
+	return err
+}
+
+// OSC777 posts a desktop notification with the OSC 777 escape, understood
+// by urxvt, foot, Ghostty and VTE based terminals.
+type OSC777 struct {
+	W io.Writer
+}
+
+func (o OSC777) Notify(e Event) error {
+	_, err := fmt.Fprintf(o.W, "\x1b]777;notify;%s;%s\x07",
+		strings.ReplaceAll(sanitize(e.Title()), ";", ","), sanitize(e.Message()))
+	return err
+}
+
+// sanitize drops the control characters that would end an escape early.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Command runs a shell command for every event. The event is passed as
+// NBA_TUI_* environment variables and as JSON on stdin.
+type Command struct {
+	Command string
+	// Run runs the prepared command. Tests replace it.
+	Run func(*exec.Cmd) error
+}
+
+func NewCommand(command string) Command {
+	return Command{
+		Command: command,
+		Run: func(cmd *exec.Cmd) error {
+			return cmd.Run()
+		},
+	}
+}
+
+func (c Command) Notify(e Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command("sh", "-c", c.Command) // #nosec G204 -- user configured hook
+	cmd.Env = append(os.Environ(),
+		"NBA_TUI_EVENT="+string(e.Kind),
+		"NBA_TUI_GAME_ID="+e.GameID,
+		"NBA_TUI_HOME="+e.Home,
+		"NBA_TUI_AWAY="+e.Away,
+		"NBA_TUI_HOME_SCORE="+strconv.Itoa(e.HomeScore),
+		"NBA_TUI_AWAY_SCORE="+strconv.Itoa(e.AwayScore),
+		"NBA_TUI_PERIOD="+strconv.Itoa(e.Period),
+		"NBA_TUI_TEAM="+e.Team,
+		"NBA_TUI_TITLE="+e.Title(),
+		"NBA_TUI_MESSAGE="+e.Message(),
+	)
+	cmd.Stdin = bytes.NewReader(payload)
+	if err := c.Run(cmd); err != nil {
+		return fmt.Errorf("notify command: %w", err)
+	}
+	return nil
+}
+
+// Backends are the notifier names accepted in the config.
+var Backends = []string{"bell", "osc9", "osc777", "command"}
+
+// NewBackend returns the notifier called name. The terminal ones write to w
+// and command is the shell command of the command backend.
+func NewBackend(name string, w io.Writer, command string) (Notifier, error) {
+	switch name {
+	case "bell":
+		return Bell{W: w}, nil
+	case "osc9":
+		return OSC9{W: w}, nil
+	case "osc777":
+		return OSC777{W: w}, nil
+	case "command":
+		if strings.TrimSpace(command) == "" {
+			return nil, errors.New("notify backend command needs a command")
+		}
+		return NewCommand(command), nil
+	}
+	return nil, fmt.Errorf("unknown notify backend %q (%s)", name, strings.Join(Backends, "|"))
+}
+
+// Multi sends every event to all of its notifiers.
+type Multi []Notifier
+
+func (m Multi) Notify(e Event) error {
+	var errs []error
+	for _, n := range m {
+		errs = append(errs, n.Notify(e))
+	}
+	return errors.Join(errs...)
+}
+
+// Only passes the events of the given kinds on to Notifier.
+type Only struct {
+	Kinds    map[Kind]bool
+	Notifier Notifier
+}
+
+func (o Only) Notify(e Event) error {
+	if !o.Kinds[e.Kind] {
+		return nil
+	}
+	return o.Notifier.Notify(e)
+}
+
+// Watcher detects events and delivers them.
+type Watcher struct {
+	Detector *Detector
+	Notifier Notifier
+}
+
+func NewWatcher(notifier Notifier) *Watcher {
+	return &Watcher{
+		Detector: NewDetector(),
+		Notifier: notifier,
+	}
+}
+
+// Deliver notifies each event in order.
+func (w *Watcher) Deliver(events []Event) error {
+	var errs []error
+	for _, e := range events {
+		errs = append(errs, w.Notifier.Notify(e))
+	}
+	return errors.Join(errs...)
+}
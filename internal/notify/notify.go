@@ -0,0 +1,373 @@
+package notify
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"nba-tui/internal/utils"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// Kind is the type of a game event.
+type Kind string
+
+const (
+	GameStart     Kind = "game_start"
+	LeadChange    Kind = "lead_change" // in the 4th quarter or overtime
+	CloseGame     Kind = "close_game"  // within CloseMargin in the last two minutes
+	Overtime      Kind = "overtime"
+	Final         Kind = "final"
+	FavoriteScore Kind = "favorite_score"
+	Milestone     Kind = "milestone" // a triple-double, 40 points and the like
+)
+
+// Kinds lists every event kind.
+var Kinds = []Kind{GameStart, LeadChange, CloseGame, Overtime, Final, FavoriteScore, Milestone}
+
+// ParseKinds parses config event names into a set.
+func ParseKinds(names []string) (map[Kind]bool, error) {
+	kinds := make(map[Kind]bool, len(names))
+	for _, name := range names {
+		kind := Kind(name)
+		known := false
+		for _, k := range Kinds {
+			known = known || k == kind
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown notification event %q", name)
+		}
+		kinds[kind] = true
+	}
+	return kinds, nil
+}
+
+// DefaultCloseMargin is the largest margin in points of a close game.
+const DefaultCloseMargin = 5
+
+// closeSeconds is when the end of a period becomes crunch time.
+const closeSeconds = 2 * 60
+
+// Event is something worth an alert that happened between two fetches.
+type Event struct {
+	Kind      Kind   `json:"kind"`
+	GameID    string `json:"game_id"`
+	Home      string `json:"home"` // tricodes
+	Away      string `json:"away"`
+	HomeScore int    `json:"home_score"`
+	AwayScore int    `json:"away_score"`
+	Period    int    `json:"period"`
+	Team      string `json:"team,omitempty"`   // the team that scored or took the lead, if any
+	Player    string `json:"player,omitempty"` // the player of a milestone
+	Detail    string `json:"detail,omitempty"` // play or milestone description, if any
+}
+
+// Score is the game's score line, like "LAL 102 - 100 GSW".
+func (e Event) Score() string {
+	return fmt.Sprintf("%s %d - %d %s", e.Home, e.HomeScore, e.AwayScore, e.Away)
+}
+
+// Title is a short headline of the event.
+func (e Event) Title() string {
+	switch e.Kind {
+	case GameStart:
+		return fmt.Sprintf("Tip-off: %s @ %s", e.Away, e.Home)
+	case LeadChange:
+		return fmt.Sprintf("Lead change: %s", e.Team)
+	case CloseGame:
+		return fmt.Sprintf("Close game: %s @ %s", e.Away, e.Home)
+	case Overtime:
+		return fmt.Sprintf("Overtime (OT%d): %s @ %s", e.Period-4, e.Away, e.Home)
+	case Final:
+		return fmt.Sprintf("Final: %s @ %s", e.Away, e.Home)
+	case FavoriteScore:
+		return fmt.Sprintf("%s scored", e.Team)
+	case Milestone:
+		return fmt.Sprintf("Milestone: %s", e.Player)
+	}
+	return string(e.Kind)
+}
+
+// Message is the event's body: the score and the play, if any.
+func (e Event) Message() string {
+	if e.Detail != "" {
+		return e.Score() + ": " + e.Detail
+	}
+	return e.Score()
+}
+
+// gameState is what the detector last knew about a game.
+type gameState struct {
+	id, home, away string
+	status, period int
+	homeScore      int
+	awayScore      int
+	leader         int             // 1 home, -1 away; ties keep the last leader
+	lastAction     int             // highest play-by-play action number seen, -1 before the first
+	closeAlerted   map[int]bool    // periods
+	milestones     map[string]bool // "<person id> <milestone>"
+	sawPlayers     bool            // a box score was seen
+}
+
+func (st *gameState) event(kind Kind, team, detail string) Event {
+	return Event{
+		Kind:      kind,
+		GameID:    st.id,
+		Home:      st.home,
+		Away:      st.away,
+		HomeScore: st.homeScore,
+		AwayScore: st.awayScore,
+		Period:    st.period,
+		Team:      team,
+		Detail:    detail,
+	}
+}
+
+// snapshot is one observation of a game. Clock is -1 when unknown.
+type snapshot struct {
+	status, period       int
+	clock                int
+	homeScore, awayScore int
+	detail               string // the play, if any
+}
+
+// Detector turns successive fetches into events. The first sight of a game
+// only sets its baseline, so nothing fires for what happened before.
+type Detector struct {
+	Favorites   map[string]bool // by tricode
+	CloseMargin int
+	games       map[string]*gameState
+}
+
+func NewDetector() *Detector {
+	return &Detector{
+		CloseMargin: DefaultCloseMargin,
+		games:       map[string]*gameState{},
+	}
+}
+
+// Scoreboard observes the games of a scoreboard or box score fetch. Only box
+// scores have the players for milestones.
+func (d *Detector) Scoreboard(games []types.Game) []Event {
+	var events []Event
+	for _, g := range games {
+		clock := -1
+		if seconds, ok := utils.ParseClock(g.GameClock); ok {
+			clock = seconds
+		}
+		next := snapshot{
+			status:    g.GameStatus,
+			period:    g.Period,
+			clock:     clock,
+			homeScore: g.HomeTeam.Score,
+			awayScore: g.AwayTeam.Score,
+		}
+
+		st, ok := d.games[g.GameId]
+		if !ok {
+			st = d.baseline(g.GameId, g.HomeTeam.TeamTricode, g.AwayTeam.TeamTricode, next)
+			d.games[g.GameId] = st
+		} else {
+			events = append(events, d.advance(st, next)...)
+		}
+		// Like the game, the first box score only sets the baseline
+		reached := st.reached(g)
+		if st.sawPlayers {
+			events = append(events, reached...)
+		}
+		st.sawPlayers = st.sawPlayers || g.HomeTeam.Players != nil || g.AwayTeam.Players != nil
+	}
+	return events
+}
+
+// PlayByPlay observes the new actions of a game the detector already knows
+// from a scoreboard or box score.
+func (d *Detector) PlayByPlay(gameID string, actions []types.Action) []Event {
+	st, ok := d.games[gameID]
+	if !ok {
+		return nil
+	}
+	first := st.lastAction < 0
+	var events []Event
+	for _, a := range actions {
+		if a.ActionNumber <= st.lastAction {
+			continue
+		}
+		st.lastAction = a.ActionNumber
+		if first {
+			continue
+		}
+		homeScore, errHome := strconv.Atoi(a.ScoreHome)
+		awayScore, errAway := strconv.Atoi(a.ScoreAway)
+		if errHome != nil || errAway != nil {
+			continue
+		}
+		clock := -1
+		if seconds, ok := utils.ParseClock(a.Clock); ok {
+			clock = seconds
+		}
+		events = append(events, d.advance(st, snapshot{
+			status:    st.status,
+			period:    a.Period,
+			clock:     clock,
+			homeScore: homeScore,
+			awayScore: awayScore,
+			detail:    strings.TrimSpace(a.Description),
+		})...)
+	}
+	return events
+}
+
+func (d *Detector) baseline(id, home, away string, s snapshot) *gameState {
+	return &gameState{
+		id:           id,
+		home:         home,
+		away:         away,
+		status:       s.status,
+		period:       s.period,
+		homeScore:    s.homeScore,
+		awayScore:    s.awayScore,
+		leader:       sign(s.homeScore - s.awayScore),
+		lastAction:   -1,
+		closeAlerted: map[int]bool{},
+		milestones:   map[string]bool{},
+	}
+}
+
+// advance moves the game to s and returns what changed. Fetches may arrive
+// out of order, so nothing ever moves backwards.
+func (d *Detector) advance(st *gameState, s snapshot) []Event {
+	var events []Event
+	event := func(kind Kind, team, detail string) {
+		events = append(events, st.event(kind, team, detail))
+	}
+
+	scored := s.homeScore >= st.homeScore && s.awayScore >= st.awayScore &&
+		(s.homeScore > st.homeScore || s.awayScore > st.awayScore)
+	homeScored := scored && s.homeScore > st.homeScore
+	awayScored := scored && s.awayScore > st.awayScore
+	if scored {
+		st.homeScore, st.awayScore = s.homeScore, s.awayScore
+	}
+
+	newPeriod := s.period > st.period
+	if newPeriod {
+		st.period = s.period
+	}
+	if st.status < 2 && s.status >= 2 {
+		st.status = s.status
+		event(GameStart, "", "")
+	}
+	if newPeriod && st.period > 4 {
+		event(Overtime, "", "")
+	}
+
+	if scored {
+		if homeScored && d.Favorites[st.home] {
+			event(FavoriteScore, st.home, s.detail)
+		}
+		if awayScored && d.Favorites[st.away] {
+			event(FavoriteScore, st.away, s.detail)
+		}
+		leader := sign(st.homeScore - st.awayScore)
+		if leader != 0 && leader != st.leader {
+			if st.leader != 0 && st.period >= 4 {
+				team := st.home
+				if leader < 0 {
+					team = st.away
+				}
+				event(LeadChange, team, s.detail)
+			}
+			st.leader = leader
+		}
+	}
+
+	margin := st.homeScore - st.awayScore
+	if margin < 0 {
+		margin = -margin
+	}
+	if st.status == 2 && s.status == 2 && st.period >= 4 && s.period == st.period &&
+		s.clock >= 0 && s.clock <= closeSeconds &&
+		margin <= d.CloseMargin && !st.closeAlerted[st.period] {
+		st.closeAlerted[st.period] = true
+		event(CloseGame, "", "")
+	}
+
+	if st.status < 3 && s.status == 3 {
+		st.status = s.status
+		event(Final, "", "")
+	}
+	return events
+}
+
+// reached returns a milestone event for every milestone the game's players
+// reached since the last box score.
+func (st *gameState) reached(g types.Game) []Event {
+	var events []Event
+	for _, team := range []types.Team{g.HomeTeam, g.AwayTeam} {
+		if team.Players == nil {
+			continue
+		}
+		for _, p := range *team.Players {
+			if p.Statistics == nil {
+				continue
+			}
+			name := strings.TrimSpace(p.FirstName + " " + p.FamilyName)
+			for _, milestone := range milestones(*p.Statistics) {
+				key := fmt.Sprintf("%d %s", p.PersonID, milestone)
+				if st.milestones[key] {
+					continue
+				}
+				st.milestones[key] = true
+				e := st.event(Milestone, team.TeamTricode, fmt.Sprintf("%s has %s", name, milestone))
+				e.Player = name
+				events = append(events, e)
+			}
+		}
+	}
+	return events
+}
+
+// milestones lists the performances worth an alert that stats reach.
+func milestones(stats types.PlayerBoxScoreStatistic) []string {
+	var reached []string
+	doubleDigits := 0
+	for _, v := range []*int{stats.Pts, stats.Reb, stats.Ast, stats.Stl, stats.Blk} {
+		if value(v) >= 10 {
+			doubleDigits++
+		}
+	}
+	if doubleDigits >= 3 {
+		reached = append(reached, "a triple-double")
+	}
+	for _, pts := range []int{40, 50, 60} {
+		if value(stats.Pts) >= pts {
+			reached = append(reached, fmt.Sprintf("%d points", pts))
+		}
+	}
+	if value(stats.Reb) >= 20 {
+		reached = append(reached, "20 rebounds")
+	}
+	if value(stats.Ast) >= 20 {
+		reached = append(reached, "20 assists")
+	}
+	return reached
+}
+
+func value(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
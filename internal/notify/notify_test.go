@@ -0,0 +1,228 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func game(status, period int, clock string, home, away int) types.Game {
+	return types.Game{
+		GameId:     "001",
+		GameStatus: status,
+		Period:     period,
+		GameClock:  clock,
+		HomeTeam:   types.Team{TeamTricode: "LAL", Score: home},
+		AwayTeam:   types.Team{TeamTricode: "BOS", Score: away},
+	}
+}
+
+func kinds(events []Event) []Kind {
+	var got []Kind
+	for _, e := range events {
+		got = append(got, e.Kind)
+	}
+	return got
+}
+
+func TestDetector_Scoreboard(t *testing.T) {
+	d := NewDetector()
+
+	// The first sight only sets the baseline
+	assert.Empty(t, d.Scoreboard([]types.Game{game(1, 0, "", 0, 0)}))
+
+	events := d.Scoreboard([]types.Game{game(2, 1, "PT12M00.00S", 0, 0)})
+	assert.Equal(t, []Kind{GameStart}, kinds(events))
+	assert.Equal(t, "Tip-off: BOS @ LAL", events[0].Title())
+	assert.Equal(t, 1, events[0].Period)
+
+	// Leads changing before the 4th are not worth an alert
+	assert.Empty(t, d.Scoreboard([]types.Game{game(2, 2, "PT05M00.00S", 40, 38)}))
+	assert.Empty(t, d.Scoreboard([]types.Game{game(2, 3, "PT05M00.00S", 70, 72)}))
+
+	events = d.Scoreboard([]types.Game{game(2, 4, "PT05M00.00S", 95, 90)})
+	assert.Equal(t, []Kind{LeadChange}, kinds(events))
+	assert.Equal(t, "LAL", events[0].Team)
+	assert.Equal(t, "LAL 95 - 90 BOS", events[0].Message())
+
+	// A tie keeps the lead with the last leader
+	assert.Empty(t, d.Scoreboard([]types.Game{game(2, 4, "PT03M00.00S", 97, 97)}))
+	assert.Empty(t, d.Scoreboard([]types.Game{game(2, 4, "PT02M30.00S", 99, 97)}))
+
+	events = d.Scoreboard([]types.Game{game(2, 4, "PT01M59.00S", 99, 98)})
+	assert.Equal(t, []Kind{CloseGame}, kinds(events))
+	// Only once a period
+	assert.Empty(t, d.Scoreboard([]types.Game{game(2, 4, "PT00M30.00S", 100, 100)}))
+
+	events = d.Scoreboard([]types.Game{game(2, 5, "PT05M00.00S", 100, 100)})
+	assert.Equal(t, []Kind{Overtime}, kinds(events))
+	assert.Equal(t, "Overtime (OT1): BOS @ LAL", events[0].Title())
+
+	events = d.Scoreboard([]types.Game{game(3, 5, "PT00M00.00S", 110, 105)})
+	assert.Equal(t, []Kind{Final}, kinds(events))
+	assert.Equal(t, 110, events[0].HomeScore)
+
+	assert.Empty(t, d.Scoreboard([]types.Game{game(3, 5, "PT00M00.00S", 110, 105)}))
+}
+
+func TestDetector_NeverMovesBackwards(t *testing.T) {
+	d := NewDetector()
+	d.Scoreboard([]types.Game{game(2, 4, "PT05M00.00S", 90, 85)})
+
+	// A stale response right after a fresher one
+	assert.Empty(t, d.Scoreboard([]types.Game{game(2, 3, "PT01M00.00S", 70, 72)}))
+	events := d.Scoreboard([]types.Game{game(2, 4, "PT04M00.00S", 90, 92)})
+	assert.Equal(t, []Kind{LeadChange}, kinds(events))
+	assert.Equal(t, "BOS", events[0].Team)
+}
+
+func TestDetector_CloseMargin(t *testing.T) {
+	d := NewDetector()
+	d.CloseMargin = 2
+	d.Scoreboard([]types.Game{game(2, 4, "PT03M00.00S", 90, 85)})
+
+	assert.Empty(t, d.Scoreboard([]types.Game{game(2, 4, "PT01M00.00S", 90, 85)}))
+	events := d.Scoreboard([]types.Game{game(2, 4, "PT00M40.00S", 90, 88)})
+	assert.Equal(t, []Kind{CloseGame}, kinds(events))
+}
+
+func TestDetector_PlayByPlay(t *testing.T) {
+	d := NewDetector()
+	d.Favorites = map[string]bool{"BOS": true}
+
+	// Unknown games are ignored until a scoreboard or box score is seen
+	assert.Empty(t, d.PlayByPlay("001", []types.Action{{ActionNumber: 1, ScoreHome: "2", ScoreAway: "0"}}))
+
+	d.Scoreboard([]types.Game{game(2, 4, "PT06M00.00S", 90, 88)})
+	first := []types.Action{
+		{ActionNumber: 1, Period: 4, Clock: "PT06M00.00S", ScoreHome: "90", ScoreAway: "88"},
+	}
+	assert.Empty(t, d.PlayByPlay("001", first))
+
+	next := append(first,
+		types.Action{ActionNumber: 2, Period: 4, Clock: "PT05M40.00S", TeamTricode: "BOS", ScoreHome: "90", ScoreAway: "91", Description: "Tatum 3PT Jump Shot"},
+		types.Action{ActionNumber: 3, Period: 4, Clock: "PT05M20.00S", TeamTricode: "LAL", ScoreHome: "", ScoreAway: "", Description: "James Rebound"},
+	)
+	events := d.PlayByPlay("001", next)
+	assert.Equal(t, []Kind{FavoriteScore, LeadChange}, kinds(events))
+	assert.Equal(t, "BOS scored", events[0].Title())
+	assert.Equal(t, "LAL 90 - 91 BOS: Tatum 3PT Jump Shot", events[0].Message())
+	assert.Equal(t, "BOS", events[1].Team)
+
+	// Already seen
+	assert.Empty(t, d.PlayByPlay("001", next))
+	// The scoreboard catching up is nothing new
+	assert.Empty(t, d.Scoreboard([]types.Game{game(2, 4, "PT05M20.00S", 90, 91)}))
+}
+
+func TestParseKinds(t *testing.T) {
+	got, err := ParseKinds([]string{"final", "overtime"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[Kind]bool{Final: true, Overtime: true}, got)
+
+	_, err = ParseKinds([]string{"buzzer_beater"})
+	assert.ErrorContains(t, err, "buzzer_beater")
+}
+
+func TestTerminalBackends(t *testing.T) {
+	e := Event{Kind: Final, Home: "LAL", Away: "BOS", HomeScore: 110, AwayScore: 105}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"bell", "\a"},
+		{"osc9", "\x1b]9;Final: BOS @ LAL: LAL 110 - 105 BOS\x07"},
+		{"osc777", "\x1b]777;notify;Final: BOS @ LAL;LAL 110 - 105 BOS\x07"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		n, err := NewBackend(tt.name, &buf, "")
+		assert.NoError(t, err)
+		assert.NoError(t, n.Notify(e))
+		assert.Equal(t, tt.want, buf.String(), tt.name)
+	}
+
+	// Control characters in a play cannot end the escape
+	var buf bytes.Buffer
+	assert.NoError(t, OSC9{W: &buf}.Notify(Event{Kind: Final, Detail: "a\x07b\x1b]c"}))
+	assert.Equal(t, 1, strings.Count(buf.String(), "\x07"))
+}
+
+func TestNewBackend_Errors(t *testing.T) {
+	_, err := NewBackend("toast", io.Discard, "")
+	assert.ErrorContains(t, err, "unknown notify backend")
+	_, err = NewBackend("command", io.Discard, " ")
+	assert.ErrorContains(t, err, "needs a command")
+}
+
+func TestCommand(t *testing.T) {
+	var ran *exec.Cmd
+	c := NewCommand(`notify-send "$NBA_TUI_TITLE"`)
+	c.Run = func(cmd *exec.Cmd) error {
+		ran = cmd
+		return nil
+	}
+	e := Event{Kind: LeadChange, GameID: "001", Home: "LAL", Away: "BOS", HomeScore: 90, AwayScore: 91, Period: 4, Team: "BOS"}
+	assert.NoError(t, c.Notify(e))
+
+	assert.Equal(t, []string{"sh", "-c", `notify-send "$NBA_TUI_TITLE"`}, ran.Args)
+	assert.Contains(t, ran.Env, "NBA_TUI_EVENT=lead_change")
+	assert.Contains(t, ran.Env, "NBA_TUI_TEAM=BOS")
+	assert.Contains(t, ran.Env, "NBA_TUI_TITLE=Lead change: BOS")
+	var got Event
+	assert.NoError(t, json.NewDecoder(ran.Stdin).Decode(&got))
+	assert.Equal(t, e, got)
+
+	c.Run = func(*exec.Cmd) error { return errors.New("exit status 1") }
+	assert.ErrorContains(t, c.Notify(e), "notify command: exit status 1")
+}
+
+func TestWatcher(t *testing.T) {
+	var bells, escapes bytes.Buffer
+	w := NewWatcher(Multi{
+		Only{Kinds: map[Kind]bool{Final: true}, Notifier: Bell{W: &bells}},
+		Only{Kinds: map[Kind]bool{GameStart: true, Final: true}, Notifier: OSC9{W: &escapes}},
+	})
+	assert.NoError(t, w.Deliver([]Event{{Kind: GameStart}, {Kind: Final}}))
+	assert.Equal(t, "\a", bells.String())
+	assert.Equal(t, 2, strings.Count(escapes.String(), "\x1b]9;"))
+}
+
+func TestDetector_Milestones(t *testing.T) {
+	stats := func(pts, reb, ast int) *types.PlayerBoxScoreStatistic {
+		s := &types.PlayerBoxScoreStatistic{}
+		s.Pts, s.Reb, s.Ast = &pts, &reb, &ast
+		return s
+	}
+	box := func(s *types.PlayerBoxScoreStatistic) types.Game {
+		g := game(2, 3, "PT05M00.00S", 80, 70)
+		players := []types.Player{{PersonID: 23, FirstName: "LeBron", FamilyName: "James", Statistics: s}}
+		g.HomeTeam.Players = &players
+		return g
+	}
+
+	d := NewDetector()
+	d.Scoreboard([]types.Game{game(2, 3, "PT06M00.00S", 78, 70)})
+	// Reached before the first box score
+	assert.Empty(t, d.Scoreboard([]types.Game{box(stats(40, 5, 5))}))
+
+	events := d.Scoreboard([]types.Game{box(stats(42, 10, 10))})
+	assert.Equal(t, []Kind{Milestone}, kinds(events))
+	assert.Equal(t, "LAL", events[0].Team)
+	assert.Equal(t, "LeBron James", events[0].Player)
+	assert.Equal(t, "LeBron James has a triple-double", events[0].Detail)
+
+	events = d.Scoreboard([]types.Game{box(stats(50, 20, 10))})
+	assert.Equal(t, []Kind{Milestone, Milestone}, kinds(events))
+	assert.Equal(t, "LeBron James has 50 points", events[0].Detail)
+	assert.Equal(t, "LeBron James has 20 rebounds", events[1].Detail)
+
+	assert.Empty(t, d.Scoreboard([]types.Game{box(stats(52, 21, 10))}))
+}
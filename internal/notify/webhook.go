@@ -0,0 +1,136 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WebhookTeam is a team in a webhook payload.
+type WebhookTeam struct {
+	Tricode string `json:"tricode"`
+	Score   int    `json:"score"`
+}
+
+// WebhookPayload is the JSON body POSTed for an event.
+type WebhookPayload struct {
+	Event       Kind        `json:"event"`
+	GameID      string      `json:"game_id"`
+	Home        WebhookTeam `json:"home"`
+	Away        WebhookTeam `json:"away"`
+	Period      int         `json:"period"`
+	Team        string      `json:"team,omitempty"`
+	Player      string      `json:"player,omitempty"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	// Text is the title and description in one line, the field chat
+	// services like Slack and Mattermost post as the message
+	Text string `json:"text"`
+}
+
+// NewWebhookPayload returns the payload of e.
+func NewWebhookPayload(e Event) WebhookPayload {
+	return WebhookPayload{
+		Event:       e.Kind,
+		GameID:      e.GameID,
+		Home:        WebhookTeam{Tricode: e.Home, Score: e.HomeScore},
+		Away:        WebhookTeam{Tricode: e.Away, Score: e.AwayScore},
+		Period:      e.Period,
+		Team:        e.Team,
+		Player:      e.Player,
+		Title:       e.Title(),
+		Description: e.Message(),
+		Text:        e.Title() + ": " + e.Message(),
+	}
+}
+
+// Webhook POSTs every event as JSON to each of its URLs. Failed POSTs are
+// retried when the failure may be temporary.
+type Webhook struct {
+	URLs    []string
+	Client  *http.Client
+	Retries int           // attempts after the first one
+	Backoff time.Duration // before the first retry, doubled for every next one
+	// DryRun writes the payloads to W instead of sending them.
+	DryRun bool
+	W      io.Writer
+	// Sleep waits between attempts. Tests replace it.
+	Sleep func(time.Duration)
+}
+
+func NewWebhook(urls []string) *Webhook {
+	return &Webhook{
+		URLs:    urls,
+		Client:  &http.Client{Timeout: 10 * time.Second},
+		Retries: 3,
+		Backoff: time.Second,
+		Sleep:   time.Sleep,
+	}
+}
+
+func (w *Webhook) Notify(e Event) error {
+	body, err := json.Marshal(NewWebhookPayload(e))
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, url := range w.URLs {
+		if w.DryRun {
+			_, err := fmt.Fprintf(w.W, "POST %s\n%s\n", url, body)
+			errs = append(errs, err)
+			continue
+		}
+		if err := w.post(url, body); err != nil {
+			errs = append(errs, fmt.Errorf("webhook %s: %w", url, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// post sends body to url, retrying network errors, 429 and 5xx.
+func (w *Webhook) post(url string, body []byte) error {
+	backoff := w.Backoff
+	for attempt := 0; ; attempt++ {
+		err := w.postOnce(url, body)
+		var status statusError
+		if err == nil || attempt >= w.Retries || (errors.As(err, &status) && !status.temporary()) {
+			return err
+		}
+		w.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (w *Webhook) postOnce(url string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "nba-tui")
+	res, err := w.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return statusError(res.StatusCode)
+	}
+	return nil
+}
+
+// statusError is a response outside 2xx.
+type statusError int
+
+func (s statusError) Error() string {
+	return fmt.Sprintf("%d %s", int(s), http.StatusText(int(s)))
+}
+
+func (s statusError) temporary() bool {
+	return s == http.StatusTooManyRequests || s >= 500
+}
@@ -0,0 +1,129 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var finalEvent = Event{Kind: Final, GameID: "001", Home: "LAL", Away: "BOS", HomeScore: 110, AwayScore: 105, Period: 4}
+
+func testWebhook(urls ...string) (*Webhook, *[]time.Duration) {
+	var slept []time.Duration
+	w := NewWebhook(urls)
+	w.Sleep = func(d time.Duration) { slept = append(slept, d) }
+	return w, &slept
+}
+
+func TestWebhook(t *testing.T) {
+	var got []WebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var p WebhookPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&p))
+		got = append(got, p)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	w, _ := testWebhook(server.URL+"/a", server.URL+"/b")
+	assert.NoError(t, w.Notify(finalEvent))
+
+	assert.Len(t, got, 2)
+	assert.Equal(t, WebhookPayload{
+		Event:       Final,
+		GameID:      "001",
+		Home:        WebhookTeam{Tricode: "LAL", Score: 110},
+		Away:        WebhookTeam{Tricode: "BOS", Score: 105},
+		Period:      4,
+		Title:       "Final: BOS @ LAL",
+		Description: "LAL 110 - 105 BOS",
+		Text:        "Final: BOS @ LAL: LAL 110 - 105 BOS",
+	}, got[0])
+}
+
+func TestWebhook_Milestone(t *testing.T) {
+	p := NewWebhookPayload(Event{Kind: Milestone, Home: "LAL", Away: "BOS", Team: "LAL", Player: "LeBron James", Detail: "LeBron James has a triple-double"})
+	assert.Equal(t, "LeBron James", p.Player)
+	assert.Equal(t, "Milestone: LeBron James", p.Title)
+	assert.Equal(t, "LAL 0 - 0 BOS: LeBron James has a triple-double", p.Description)
+}
+
+func TestWebhook_Retries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	w, slept := testWebhook(server.URL)
+	assert.NoError(t, w.Notify(finalEvent))
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *slept)
+}
+
+func TestWebhook_GivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	w, _ := testWebhook(server.URL)
+	w.Retries = 2
+	err := w.Notify(finalEvent)
+	assert.ErrorContains(t, err, "429 Too Many Requests")
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestWebhook_NoRetryOnClientError(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	w, slept := testWebhook(server.URL)
+	err := w.Notify(finalEvent)
+	assert.ErrorContains(t, err, "webhook "+server.URL+": 404 Not Found")
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Empty(t, *slept)
+}
+
+func TestWebhook_NetworkError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	w, slept := testWebhook(url)
+	assert.Error(t, w.Notify(finalEvent))
+	assert.Len(t, *slept, 3)
+}
+
+func TestWebhook_DryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("dry run sent a request")
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	w, _ := testWebhook(server.URL)
+	w.DryRun = true
+	w.W = &out
+	assert.NoError(t, w.Notify(finalEvent))
+	assert.Contains(t, out.String(), "POST "+server.URL+"\n")
+	assert.Contains(t, out.String(), `"event":"final"`)
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"nba-tui/internal/ui/root"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// DefaultPollInterval is how often streams fetch the play-by-play unless
+// PollInterval says otherwise.
+const DefaultPollInterval = 10 * time.Second
+
+// gameIDRe matches NBA game ids, which are all digits.
+var gameIDRe = regexp.MustCompile(`^\d+$`)
+
+// Server serves the data the TUI shows as JSON:
+//
+//	GET /scoreboard[?date=YYYY-MM-DD]
+//	GET /games/{id}/boxscore
+//	GET /games/{id}/pbp
+//	GET /games/{id}/stream[?format=ndjson][&since=N]
+type Server struct {
+	// PollInterval is how often streams fetch the play-by-play. Zero or
+	// less is DefaultPollInterval.
+	PollInterval time.Duration
+	client       root.Client
+	mux          *http.ServeMux
+}
+
+// New returns a server fetching from client. Wrap the client in a
+// nba.CachingClient so all requests share its fetches.
+func New(client root.Client) *Server {
+	s := &Server{PollInterval: DefaultPollInterval, client: client, mux: http.NewServeMux()}
+	s.mux.HandleFunc("GET /scoreboard", s.scoreboard)
+	s.mux.HandleFunc("GET /games/{id}/boxscore", s.gameHandler(func(id string) (any, error) {
+		return s.client.GetBoxScore(id)
+	}))
+	s.mux.HandleFunc("GET /games/{id}/pbp", s.gameHandler(func(id string) (any, error) {
+		return s.client.GetPlayByPlay(id)
+	}))
+	s.mux.HandleFunc("GET /games/{id}/stream", s.stream)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Dashboards on other hosts fetch from the browser
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	s.mux.ServeHTTP(w, r)
+}
+
+// Scoreboard is the body of /scoreboard.
+type Scoreboard struct {
+	Date  string       `json:"date,omitempty"` // empty for today's live slate
+	Games []types.Game `json:"games"`
+}
+
+func (s *Server) scoreboard(w http.ResponseWriter, r *http.Request) {
+	date := r.URL.Query().Get("date")
+	var games []types.Game
+	var err error
+	if date == "" {
+		games, err = s.client.GetScoreboard()
+	} else {
+		day, parseErr := time.ParseInLocation("2006-01-02", date, time.Local)
+		if parseErr != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("date must be YYYY-MM-DD, got %q", date))
+			return
+		}
+		games, err = s.client.GetScoreboardByDate(day)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if games == nil {
+		games = []types.Game{}
+	}
+	writeJSON(w, http.StatusOK, Scoreboard{Date: date, Games: games})
+}
+
+// gameHandler serves what fetch returns for the game in the path.
+func (s *Server) gameHandler(fetch func(id string) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if !gameIDRe.MatchString(id) {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid game id %q", id))
+			return
+		}
+		res, err := fetch(id)
+		if err != nil {
+			writeError(w, http.StatusBadGateway, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, res)
+	}
+}
+
+// Error is the body of every failed request.
+type Error struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, Error{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
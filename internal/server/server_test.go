@@ -0,0 +1,103 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClient struct {
+	requestedDate time.Time
+	err           error
+}
+
+func (m *mockClient) GetScoreboard() ([]types.Game, error) {
+	return []types.Game{{GameId: "0022300001", GameStatus: 2}}, m.err
+}
+func (m *mockClient) GetScoreboardByDate(date time.Time) ([]types.Game, error) {
+	m.requestedDate = date
+	return nil, m.err
+}
+func (m *mockClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error) {
+	return types.LiveBoxScoreResponse{Game: types.Game{GameId: gameID, HomeTeam: types.Team{TeamTricode: "LAL"}}}, m.err
+}
+func (m *mockClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error) {
+	return types.LivePlayByPlayResponse{Game: types.PlayByPlayGame{
+		GameID:  gameID,
+		Actions: []types.Action{{ActionNumber: 7, Description: "James Dunk"}},
+	}}, m.err
+}
+
+func get(t *testing.T, s *Server, path string, v any) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	if v != nil {
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), v))
+	}
+	return rec
+}
+
+func TestServer_Scoreboard(t *testing.T) {
+	client := &mockClient{}
+	s := New(client)
+
+	var board Scoreboard
+	rec := get(t, s, "/scoreboard", &board)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "0022300001", board.Games[0].GameId)
+
+	rec = get(t, s, "/scoreboard?date=2024-01-02", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"date":"2024-01-02","games":[]}`, rec.Body.String())
+	assert.Equal(t, "2024-01-02", client.requestedDate.Format("2006-01-02"))
+
+	var apiErr Error
+	rec = get(t, s, "/scoreboard?date=yesterday", &apiErr)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, apiErr.Error, "YYYY-MM-DD")
+}
+
+func TestServer_Games(t *testing.T) {
+	s := New(&mockClient{})
+
+	var box types.LiveBoxScoreResponse
+	rec := get(t, s, "/games/0022300001/boxscore", &box)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "0022300001", box.Game.GameId)
+	assert.Equal(t, "LAL", box.Game.HomeTeam.TeamTricode)
+
+	var pbp types.LivePlayByPlayResponse
+	rec = get(t, s, "/games/0022300001/pbp", &pbp)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "James Dunk", pbp.Game.Actions[0].Description)
+
+	rec = get(t, s, "/games/..%2Fetc/pbp", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = get(t, s, "/games/0022300001/shots", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/scoreboard", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestServer_UpstreamError(t *testing.T) {
+	s := New(&mockClient{err: errors.New("stats.nba.com timed out")})
+
+	for _, path := range []string{"/scoreboard", "/games/1/boxscore", "/games/1/pbp"} {
+		var apiErr Error
+		rec := get(t, s, path, &apiErr)
+		assert.Equal(t, http.StatusBadGateway, rec.Code, path)
+		assert.Equal(t, "stats.nba.com timed out", apiErr.Error, path)
+	}
+}
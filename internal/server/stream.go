@@ -0,0 +1,202 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// Update types of a game stream.
+const (
+	UpdateAction = "action" // a new play-by-play action
+	UpdateScore  = "score"  // the score changed, or the score when the stream starts
+	UpdateEnd    = "end"    // the game is over and the stream closes
+	UpdateError  = "error"  // a fetch failed; the stream goes on
+)
+
+// Update is one message of a game stream.
+type Update struct {
+	Type   string        `json:"type"`
+	GameID string        `json:"game_id"`
+	Action *types.Action `json:"action,omitempty"`
+	Score  *Score        `json:"score,omitempty"`
+	Error  string        `json:"error,omitempty"`
+	// ID is the number of the last action seen, the SSE event id
+	ID int `json:"-"`
+}
+
+// Score is the score after an action.
+type Score struct {
+	Home   int    `json:"home"`
+	Away   int    `json:"away"`
+	Period int    `json:"period"`
+	Clock  string `json:"clock"`
+}
+
+// differ turns consecutive play-by-play responses of a game into updates.
+type differ struct {
+	gameID      string
+	lastAction  int
+	skipBacklog bool // the actions of the first response are not sent
+	seen        bool
+	score       *Score
+	ended       bool
+}
+
+// newDiffer starts after action since. A negative since starts at the
+// first response, with only its score.
+func newDiffer(gameID string, since int) *differ {
+	return &differ{gameID: gameID, lastAction: max(since, 0), skipBacklog: since < 0}
+}
+
+func (d *differ) diff(actions []types.Action) []Update {
+	skip := d.skipBacklog && !d.seen
+	d.seen = true
+
+	var updates []Update
+	send := func(u Update) {
+		if !skip {
+			u.GameID, u.ID = d.gameID, d.lastAction
+			updates = append(updates, u)
+		}
+	}
+	for _, a := range actions {
+		if d.ended {
+			break
+		}
+		if a.ActionNumber <= d.lastAction {
+			// Sent before or resumed after: only keep up with the score
+			d.updateScore(a)
+			continue
+		}
+		d.lastAction = a.ActionNumber
+		send(Update{Type: UpdateAction, Action: &a})
+		if d.updateScore(a) {
+			send(Update{Type: UpdateScore, Score: d.score})
+		}
+		if a.ActionType == "game" {
+			d.ended = true
+			send(Update{Type: UpdateEnd})
+		}
+	}
+
+	if skip {
+		skip = false
+		if d.score != nil {
+			send(Update{Type: UpdateScore, Score: d.score})
+		}
+		if d.ended {
+			send(Update{Type: UpdateEnd})
+		}
+	}
+	return updates
+}
+
+// updateScore reports whether the score after a differs from the last one.
+func (d *differ) updateScore(a types.Action) bool {
+	home, errHome := strconv.Atoi(a.ScoreHome)
+	away, errAway := strconv.Atoi(a.ScoreAway)
+	if errHome != nil || errAway != nil || (d.score != nil && home == d.score.Home && away == d.score.Away) {
+		return false
+	}
+	d.score = &Score{Home: home, Away: away, Period: a.Period, Clock: a.Clock}
+	return true
+}
+
+// stream sends the new actions and score changes of a game as they come,
+// as server-sent events or, with ?format=ndjson, as JSON lines. It polls
+// the play-by-play every PollInterval and ends with the game.
+//
+// Only what happens after the request is sent unless ?since=N or the SSE
+// Last-Event-ID asks for the actions after action N.
+func (s *Server) stream(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !gameIDRe.MatchString(id) {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid game id %q", id))
+		return
+	}
+	since := -1
+	if v := r.URL.Query().Get("since"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("since must be an action number, got %q", v))
+			return
+		}
+		since = n
+	} else if n, err := strconv.Atoi(r.Header.Get("Last-Event-ID")); err == nil && n >= 0 {
+		since = n
+	}
+
+	ndjson := r.URL.Query().Get("format") == "ndjson" ||
+		strings.Contains(r.Header.Get("Accept"), "application/x-ndjson")
+	if ndjson {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+
+	interval := s.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	d := newDiffer(id, since)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		var updates []Update
+		res, err := s.client.GetPlayByPlay(id)
+		if err != nil {
+			updates = []Update{{Type: UpdateError, GameID: id, Error: err.Error(), ID: d.lastAction}}
+		} else {
+			updates = d.diff(res.Game.Actions)
+		}
+
+		for _, u := range updates {
+			if ndjson {
+				err = writeNDJSON(w, u)
+			} else {
+				err = writeEvent(w, u)
+			}
+			if err != nil {
+				return
+			}
+		}
+		if len(updates) == 0 && !ndjson {
+			// Keeps proxies from closing an idle stream
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil || d.ended {
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func writeNDJSON(w io.Writer, u Update) error {
+	return json.NewEncoder(w).Encode(u)
+}
+
+func writeEvent(w io.Writer, u Update) error {
+	data, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", u.ID, u.Type, data)
+	return err
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func action(n int, actionType string, home, away string) types.Action {
+	return types.Action{ActionNumber: n, Period: 4, Clock: "PT01M00.00S", ActionType: actionType, ScoreHome: home, ScoreAway: away}
+}
+
+func updateTypes(updates []Update) []string {
+	var got []string
+	for _, u := range updates {
+		got = append(got, u.Type)
+	}
+	return got
+}
+
+func TestDiffer(t *testing.T) {
+	d := newDiffer("001", -1)
+	backlog := []types.Action{action(1, "2pt", "2", "0"), action(2, "rebound", "", "")}
+
+	// Only the score of what came before
+	updates := d.diff(backlog)
+	assert.Equal(t, []string{UpdateScore}, updateTypes(updates))
+	assert.Equal(t, Score{Home: 2, Away: 0, Period: 4, Clock: "PT01M00.00S"}, *updates[0].Score)
+	assert.Equal(t, 2, updates[0].ID)
+
+	assert.Empty(t, d.diff(backlog))
+
+	next := append(backlog, action(4, "3pt", "2", "3"), action(5, "foul", "2", "3"))
+	updates = d.diff(next)
+	assert.Equal(t, []string{UpdateAction, UpdateScore, UpdateAction}, updateTypes(updates))
+	assert.Equal(t, 4, updates[0].Action.ActionNumber)
+	assert.Equal(t, 3, updates[1].Score.Away)
+	assert.Equal(t, 5, updates[2].ID)
+	assert.Equal(t, "001", updates[2].GameID)
+
+	updates = d.diff(append(next, action(6, "game", "2", "3"), action(7, "period", "2", "3")))
+	assert.Equal(t, []string{UpdateAction, UpdateEnd}, updateTypes(updates))
+	assert.True(t, d.ended)
+}
+
+func TestDiffer_Since(t *testing.T) {
+	actions := []types.Action{action(1, "2pt", "2", "0"), action(2, "2pt", "2", "2")}
+
+	updates := newDiffer("001", 1).diff(actions)
+	assert.Equal(t, []string{UpdateAction, UpdateScore}, updateTypes(updates))
+	assert.Equal(t, 2, updates[0].Action.ActionNumber)
+
+	updates = newDiffer("001", 0).diff(actions)
+	assert.Equal(t, []string{UpdateAction, UpdateScore, UpdateAction, UpdateScore}, updateTypes(updates))
+}
+
+func TestDiffer_NotStarted(t *testing.T) {
+	d := newDiffer("001", -1)
+	assert.Empty(t, d.diff(nil))
+	// The first actions after the start of the stream are new
+	updates := d.diff([]types.Action{action(1, "jumpball", "0", "0")})
+	assert.Equal(t, []string{UpdateAction, UpdateScore}, updateTypes(updates))
+}
+
+// scriptedClient returns one more play-by-play response on every call and
+// the last one from then on.
+type scriptedClient struct {
+	mockClient
+	mu        sync.Mutex
+	responses [][]types.Action
+}
+
+func (c *scriptedClient) GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	actions := c.responses[0]
+	if len(c.responses) > 1 {
+		c.responses = c.responses[1:]
+	}
+	return types.LivePlayByPlayResponse{Game: types.PlayByPlayGame{GameID: gameID, Actions: actions}}, nil
+}
+
+func streamServer() *httptest.Server {
+	first := []types.Action{action(1, "jumpball", "0", "0")}
+	second := append(first, action(2, "2pt", "2", "0"))
+	third := append(second, action(3, "game", "2", "0"))
+	s := New(&scriptedClient{responses: [][]types.Action{first, first, second, third}})
+	s.PollInterval = time.Millisecond
+	return httptest.NewServer(s)
+}
+
+func TestServer_StreamSSE(t *testing.T) {
+	server := streamServer()
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/games/001/stream")
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	// The stream ends with the game
+	var events []string
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "id: ") || strings.HasPrefix(line, "event: ") {
+			events = append(events, line)
+		}
+	}
+	assert.Equal(t, []string{
+		"id: 1", "event: score",
+		"id: 2", "event: action",
+		"id: 2", "event: score",
+		"id: 3", "event: action",
+		"id: 3", "event: end",
+	}, events)
+}
+
+func TestServer_StreamNDJSON(t *testing.T) {
+	server := streamServer()
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/games/001/stream?format=ndjson&since=0")
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"))
+
+	var updates []Update
+	dec := json.NewDecoder(res.Body)
+	for dec.More() {
+		var u Update
+		assert.NoError(t, dec.Decode(&u))
+		updates = append(updates, u)
+	}
+	assert.Equal(t, []string{UpdateAction, UpdateScore, UpdateAction, UpdateScore, UpdateAction, UpdateEnd}, updateTypes(updates))
+	assert.Equal(t, "jumpball", updates[0].Action.ActionType)
+	assert.Equal(t, 2, updates[3].Score.Home)
+}
+
+func TestServer_StreamResumes(t *testing.T) {
+	server := streamServer()
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/games/001/stream?format=ndjson", nil)
+	req.Header.Set("Last-Event-ID", "2")
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	var got []string
+	dec := json.NewDecoder(res.Body)
+	for dec.More() {
+		var u Update
+		assert.NoError(t, dec.Decode(&u))
+		got = append(got, u.Type)
+	}
+	assert.Equal(t, []string{UpdateAction, UpdateEnd}, got)
+}
+
+func TestServer_StreamBadRequest(t *testing.T) {
+	s := New(&mockClient{})
+	rec := get(t, s, "/games/abc/stream", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = get(t, s, "/games/001/stream?since=last", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_StreamWithoutPollInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		first := []types.Action{action(1, "jumpball", "0", "0"), action(2, "game", "0", "0")}
+		s := New(&scriptedClient{responses: [][]types.Action{first}})
+		s.PollInterval = interval
+		rec := get(t, s, "/games/001/stream?format=ndjson&since=1", nil)
+		assert.Equal(t, http.StatusOK, rec.Code, interval)
+		assert.Contains(t, rec.Body.String(), `"type":"end"`, interval)
+	}
+}
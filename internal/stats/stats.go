@@ -0,0 +1,174 @@
+package stats
+
+import (
+	"fmt"
+
+	"nba-tui/internal/utils"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// Line is a box score line with missing values as zero.
+type Line struct {
+	Minutes float64
+	Pts     int
+	FgM     int
+	FgA     int
+	Fg3M    int
+	FtM     int
+	FtA     int
+	OReb    int
+	DReb    int
+	Ast     int
+	Stl     int
+	Blk     int
+	Tov     int
+	PF      int
+}
+
+// parseMinutes reads the feed's minutes (PT34M12.00S) as decimal minutes.
+// Fractional seconds are dropped; usage only needs the minutes' ratio.
+func parseMinutes(s string) float64 {
+	seconds, _ := utils.ParseClock(s)
+	return float64(seconds) / 60
+}
+
+func NewLine(s types.CommonBoxScoreStatistic) Line {
+	v := func(p *int) int {
+		if p == nil {
+			return 0
+		}
+		return *p
+	}
+	return Line{
+		Minutes: parseMinutes(s.Minutes),
+		Pts:     v(s.Pts),
+		FgM:     v(s.FgM),
+		FgA:     v(s.FgA),
+		Fg3M:    v(s.Fg3M),
+		FtM:     v(s.FtM),
+		FtA:     v(s.FtA),
+		OReb:    v(s.OReb),
+		DReb:    v(s.DReb),
+		Ast:     v(s.Ast),
+		Stl:     v(s.Stl),
+		Blk:     v(s.Blk),
+		Tov:     v(s.Tov),
+		PF:      v(s.PF),
+	}
+}
+
+// TrueShooting is PTS / (2 * (FGA + 0.44 * FTA)).
+func TrueShooting(l Line) (float64, bool) {
+	attempts := float64(l.FgA) + 0.44*float64(l.FtA)
+	if attempts == 0 {
+		return 0, false
+	}
+	return float64(l.Pts) / (2 * attempts), true
+}
+
+// EffectiveFG is (FGM + 0.5 * 3PM) / FGA.
+func EffectiveFG(l Line) (float64, bool) {
+	if l.FgA == 0 {
+		return 0, false
+	}
+	return (float64(l.FgM) + 0.5*float64(l.Fg3M)) / float64(l.FgA), true
+}
+
+// AssistToTurnover is AST / TO, undefined without turnovers.
+func AssistToTurnover(l Line) (float64, bool) {
+	if l.Tov == 0 {
+		return 0, false
+	}
+	return float64(l.Ast) / float64(l.Tov), true
+}
+
+// GameScore is John Hollinger's game score.
+func GameScore(l Line) float64 {
+	return float64(l.Pts) +
+		0.4*float64(l.FgM) -
+		0.7*float64(l.FgA) -
+		0.4*float64(l.FtA-l.FtM) +
+		0.7*float64(l.OReb) +
+		0.3*float64(l.DReb) +
+		float64(l.Stl) +
+		0.7*float64(l.Ast) +
+		0.7*float64(l.Blk) -
+		0.4*float64(l.PF) -
+		float64(l.Tov)
+}
+
+// Usage approximates the share of team plays used by a player while on the
+// floor, from box score totals only:
+// (FGA + 0.44 * FTA + TO) * (team MIN / 5) / (MIN * (team FGA + 0.44 * team FTA + team TO)).
+func Usage(player, team Line) (float64, bool) {
+	teamPlays := float64(team.FgA) + 0.44*float64(team.FtA) + float64(team.Tov)
+	if player.Minutes == 0 || team.Minutes == 0 || teamPlays == 0 {
+		return 0, false
+	}
+	plays := float64(player.FgA) + 0.44*float64(player.FtA) + float64(player.Tov)
+	return plays * (team.Minutes / 5) / (player.Minutes * teamPlays), true
+}
+
+// Columns are the advanced stat columns in the order of PlayerValues.
+var Columns = []string{"TS%", "eFG%", "AST/TO", "GmSc", "USG%"}
+
+func formatPct(v float64, ok bool) string {
+	if !ok {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", v*100)
+}
+
+func formatRatio(v float64, ok bool) string {
+	if !ok {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f", v)
+}
+
+func teamLine(team types.Team) Line {
+	if team.Statistics == nil {
+		return Line{}
+	}
+	return NewLine(team.Statistics.CommonBoxScoreStatistic)
+}
+
+func dashes() []string {
+	values := make([]string, len(Columns))
+	for i := range values {
+		values[i] = "-"
+	}
+	return values
+}
+
+// PlayerValues returns the formatted advanced stats of a player of team.
+func PlayerValues(p types.Player, team types.Team) []string {
+	if p.Statistics == nil {
+		return dashes()
+	}
+	line := NewLine(p.Statistics.CommonBoxScoreStatistic)
+	return []string{
+		formatPct(TrueShooting(line)),
+		formatPct(EffectiveFG(line)),
+		formatRatio(AssistToTurnover(line)),
+		fmt.Sprintf("%.1f", GameScore(line)),
+		formatPct(Usage(line, teamLine(team))),
+	}
+}
+
+// TeamValues returns the formatted advanced stats of a team's totals.
+// Usage is left out as it is always 100% for a team.
+func TeamValues(team types.Team) []string {
+	if team.Statistics == nil {
+		return dashes()
+	}
+	line := teamLine(team)
+	return []string{
+		formatPct(TrueShooting(line)),
+		formatPct(EffectiveFG(line)),
+		formatRatio(AssistToTurnover(line)),
+		fmt.Sprintf("%.1f", GameScore(line)),
+		"-",
+	}
+}
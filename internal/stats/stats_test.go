@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// A 30 point night: 11-20 FG, 3-7 3P, 5-6 FT
+func starLine() Line {
+	return Line{
+		Minutes: 36, Pts: 30, FgM: 11, FgA: 20, Fg3M: 3, FtM: 5, FtA: 6,
+		OReb: 1, DReb: 7, Ast: 8, Stl: 2, Blk: 1, Tov: 4, PF: 2,
+	}
+}
+
+func TestNewLine(t *testing.T) {
+	line := NewLine(types.CommonBoxScoreStatistic{
+		Minutes: "PT34M30.00S",
+		Pts:     ptr(12),
+		FgA:     ptr(9),
+	})
+	assert.InDelta(t, 34.5, line.Minutes, 0.001)
+	assert.Equal(t, 12, line.Pts)
+	assert.Equal(t, 9, line.FgA)
+	assert.Equal(t, 0, line.Ast)
+
+	assert.Zero(t, NewLine(types.CommonBoxScoreStatistic{Minutes: "bogus"}).Minutes)
+}
+
+func TestTrueShooting(t *testing.T) {
+	ts, ok := TrueShooting(starLine())
+	assert.True(t, ok)
+	assert.InDelta(t, 30/(2*(20+0.44*6)), ts, 1e-9)
+
+	_, ok = TrueShooting(Line{Pts: 0})
+	assert.False(t, ok)
+
+	// Free throws alone count as attempts
+	ts, ok = TrueShooting(Line{Pts: 2, FtM: 2, FtA: 2})
+	assert.True(t, ok)
+	assert.InDelta(t, 2/(2*0.88), ts, 1e-9)
+}
+
+func TestEffectiveFG(t *testing.T) {
+	efg, ok := EffectiveFG(starLine())
+	assert.True(t, ok)
+	assert.InDelta(t, 0.625, efg, 1e-9)
+
+	_, ok = EffectiveFG(Line{})
+	assert.False(t, ok)
+}
+
+func TestAssistToTurnover(t *testing.T) {
+	ratio, ok := AssistToTurnover(starLine())
+	assert.True(t, ok)
+	assert.InDelta(t, 2.0, ratio, 1e-9)
+
+	_, ok = AssistToTurnover(Line{Ast: 5})
+	assert.False(t, ok)
+}
+
+func TestGameScore(t *testing.T) {
+	// 30 + 4.4 - 14 - 0.4 + 0.7 + 2.1 + 2 + 5.6 + 0.7 - 0.8 - 4
+	assert.InDelta(t, 26.3, GameScore(starLine()), 1e-9)
+	assert.Zero(t, GameScore(Line{}))
+}
+
+func TestUsage(t *testing.T) {
+	team := Line{Minutes: 240, FgA: 88, FtA: 25, Tov: 14}
+	usage, ok := Usage(starLine(), team)
+	assert.True(t, ok)
+	// (20 + 2.64 + 4) * 48 / (36 * (88 + 11 + 14))
+	assert.InDelta(t, 26.64*48/(36*113), usage, 1e-9)
+
+	_, ok = Usage(Line{FgA: 3}, team)
+	assert.False(t, ok, "no minutes")
+	_, ok = Usage(starLine(), Line{})
+	assert.False(t, ok, "no team totals")
+}
+
+func TestPlayerValues(t *testing.T) {
+	team := types.Team{Statistics: &types.TeamBoxScoreStatistic{
+		CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
+			Minutes: "PT240M00.00S", FgA: ptr(80), FtA: ptr(20), Tov: ptr(12),
+		},
+	}}
+	player := types.Player{Statistics: &types.PlayerBoxScoreStatistic{
+		CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
+			Minutes: "PT24M00.00S", Pts: ptr(10), FgM: ptr(4), FgA: ptr(8), Fg3M: ptr(2), Ast: ptr(3),
+		},
+	}}
+
+	assert.Equal(t, []string{"62.5", "62.5", "-", "8.1", "15.9"}, PlayerValues(player, team))
+	assert.Equal(t, []string{"-", "-", "-", "-", "-"}, PlayerValues(types.Player{}, team))
+	assert.Len(t, TeamValues(team), len(Columns))
+	assert.Equal(t, "-", TeamValues(team)[4])
+	assert.Equal(t, []string{"-", "-", "-", "-", "-"}, TeamValues(types.Team{}))
+}
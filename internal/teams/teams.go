@@ -0,0 +1,95 @@
+package teams
+
+import (
+	_ "embed"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Team is the static metadata of an NBA franchise.
+type Team struct {
+	Tricode    string `json:"tricode"`
+	City       string `json:"city"`
+	Name       string `json:"name"`
+	Conference string `json:"conference"` // East or West
+	Division   string `json:"division"`
+	Primary    string `json:"primary"` // official colors as #RRGGBB
+	Secondary  string `json:"secondary"`
+}
+
+//go:embed teams.json
+var teamsJSON []byte
+
+var all = mustLoad()
+
+func mustLoad() []Team {
+	var teams []Team
+	if err := json.Unmarshal(teamsJSON, &teams); err != nil {
+		panic("teams.json: " + err.Error())
+	}
+	return teams
+}
+
+// All returns every team in tricode order.
+func All() []Team {
+	return append([]Team(nil), all...)
+}
+
+// Lookup returns the team with the tricode, ignoring case.
+func Lookup(tricode string) (Team, bool) {
+	for _, t := range all {
+		if strings.EqualFold(t.Tricode, tricode) {
+			return t, true
+		}
+	}
+	return Team{}, false
+}
+
+// FullName is the city and the name, like "Boston Celtics".
+func (t Team) FullName() string {
+	return t.City + " " + t.Name
+}
+
+// minContrast is the WCAG contrast ratio for large text.
+const minContrast = 3
+
+// Color returns the primary color, or the secondary one when the primary
+// is too hard to read on the terminal background and the secondary is better.
+func (t Team) Color(darkBackground bool) string {
+	background := "#FFFFFF"
+	if darkBackground {
+		background = "#000000"
+	}
+	primary := Contrast(t.Primary, background)
+	if primary >= minContrast || Contrast(t.Secondary, background) <= primary {
+		return t.Primary
+	}
+	return t.Secondary
+}
+
+// Contrast returns the WCAG contrast ratio of two #RRGGBB colors, from 1 to 21.
+func Contrast(a, b string) float64 {
+	la, lb := luminance(a), luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// luminance is the WCAG relative luminance of a #RRGGBB color, 0 when invalid.
+func luminance(hex string) float64 {
+	rgb, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil || len(hex) != 7 {
+		return 0
+	}
+	channel := func(shift uint) float64 {
+		c := float64((rgb>>shift)&0xFF) / 255
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(16) + 0.7152*channel(8) + 0.0722*channel(0)
+}
@@ -0,0 +1,60 @@
+package teams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	all := All()
+	assert.Len(t, all, 30)
+
+	divisions := map[string]int{}
+	conferences := map[string]int{}
+	for _, team := range all {
+		divisions[team.Division]++
+		conferences[team.Conference]++
+		assert.Len(t, team.Primary, 7, team.Tricode)
+		assert.Len(t, team.Secondary, 7, team.Tricode)
+	}
+	assert.Len(t, divisions, 6)
+	for division, n := range divisions {
+		assert.Equal(t, 5, n, division)
+	}
+	assert.Equal(t, map[string]int{"East": 15, "West": 15}, conferences)
+}
+
+func TestLookup(t *testing.T) {
+	team, ok := Lookup("gsw")
+	assert.True(t, ok)
+	assert.Equal(t, "Golden State Warriors", team.FullName())
+	assert.Equal(t, "West", team.Conference)
+	assert.Equal(t, "Pacific", team.Division)
+
+	_, ok = Lookup("XXX")
+	assert.False(t, ok)
+}
+
+func TestColor(t *testing.T) {
+	bos, _ := Lookup("BOS")
+	assert.Equal(t, "#007A33", bos.Color(true))
+	assert.Equal(t, "#007A33", bos.Color(false))
+
+	// Black and navy primaries are unreadable on a dark background
+	bkn, _ := Lookup("BKN")
+	assert.Equal(t, "#FFFFFF", bkn.Color(true))
+	assert.Equal(t, "#000000", bkn.Color(false))
+	den, _ := Lookup("DEN")
+	assert.Equal(t, "#FEC524", den.Color(true))
+
+	// Light silver is unreadable on a light background
+	sas, _ := Lookup("SAS")
+	assert.Equal(t, "#000000", sas.Color(false))
+}
+
+func TestContrast(t *testing.T) {
+	assert.InDelta(t, 21, Contrast("#000000", "#FFFFFF"), 0.01)
+	assert.InDelta(t, 1, Contrast("#123456", "#123456"), 0.01)
+	assert.InDelta(t, Contrast("#E03A3E", "#000000"), Contrast("#000000", "#E03A3E"), 0.001)
+}
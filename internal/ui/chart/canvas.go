@@ -0,0 +1,158 @@
+package chart
+
+import "strings"
+
+// Canvas is a dot grid rendered into terminal cells.
+// Braille packs 2x4 dots into a cell, ASCII uses one dot per cell.
+type Canvas interface {
+	// DotSize returns the drawable area in dots.
+	DotSize() (width, height int)
+	// Set marks the dot at x, y. The origin is the top-left corner.
+	Set(x, y int)
+	// SetRune draws r over the cell containing dot x, y.
+	SetRune(x, y int, r rune)
+	String() string
+}
+
+// NewCanvas returns a braille canvas, or an ASCII one when plain is true.
+func NewCanvas(width, height int, plain bool) Canvas {
+	if plain {
+		return NewASCIICanvas(width, height, '*')
+	}
+	return NewBrailleCanvas(width, height)
+}
+
+// brailleBits maps a dot position within a cell to its braille bit.
+var brailleBits = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+type BrailleCanvas struct {
+	width, height int
+	cells         [][]rune
+	overlay       [][]rune
+}
+
+func NewBrailleCanvas(width, height int) *BrailleCanvas {
+	c := &BrailleCanvas{width: width, height: height}
+	c.cells = make([][]rune, height)
+	c.overlay = make([][]rune, height)
+	for i := range c.cells {
+		c.cells[i] = make([]rune, width)
+		c.overlay[i] = make([]rune, width)
+	}
+	return c
+}
+
+func (c *BrailleCanvas) DotSize() (int, int) {
+	return c.width * 2, c.height * 4
+}
+
+func (c *BrailleCanvas) Set(x, y int) {
+	if x < 0 || y < 0 || x >= c.width*2 || y >= c.height*4 {
+		return
+	}
+	c.cells[y/4][x/2] |= brailleBits[y%4][x%2]
+}
+
+func (c *BrailleCanvas) SetRune(x, y int, r rune) {
+	if x < 0 || y < 0 || x >= c.width*2 || y >= c.height*4 {
+		return
+	}
+	c.overlay[y/4][x/2] = r
+}
+
+func (c *BrailleCanvas) String() string {
+	lines := make([]string, c.height)
+	for y := range c.cells {
+		var b strings.Builder
+		for x, bits := range c.cells[y] {
+			switch {
+			case c.overlay[y][x] != 0:
+				b.WriteRune(c.overlay[y][x])
+			case bits == 0:
+				b.WriteRune(' ')
+			default:
+				b.WriteRune(0x2800 + bits)
+			}
+		}
+		lines[y] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+type ASCIICanvas struct {
+	width, height int
+	dot           rune
+	cells         [][]rune
+}
+
+func NewASCIICanvas(width, height int, dot rune) *ASCIICanvas {
+	c := &ASCIICanvas{width: width, height: height, dot: dot}
+	c.cells = make([][]rune, height)
+	for i := range c.cells {
+		c.cells[i] = []rune(strings.Repeat(" ", width))
+	}
+	return c
+}
+
+func (c *ASCIICanvas) DotSize() (int, int) {
+	return c.width, c.height
+}
+
+func (c *ASCIICanvas) Set(x, y int) {
+	c.SetRune(x, y, c.dot)
+}
+
+func (c *ASCIICanvas) SetRune(x, y int, r rune) {
+	if x < 0 || y < 0 || x >= c.width || y >= c.height {
+		return
+	}
+	c.cells[y][x] = r
+}
+
+func (c *ASCIICanvas) String() string {
+	lines := make([]string, c.height)
+	for y, row := range c.cells {
+		lines[y] = string(row)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Line draws a straight line between two dots.
+func Line(c Canvas, x0, y0, x1, y1 int) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		c.Set(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,51 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrailleCanvas(t *testing.T) {
+	c := NewBrailleCanvas(2, 1)
+	w, h := c.DotSize()
+	assert.Equal(t, 4, w)
+	assert.Equal(t, 4, h)
+
+	c.Set(0, 0)
+	c.Set(1, 3)
+	c.Set(10, 10) // out of range is ignored
+	assert.Equal(t, "⢁ ", c.String())
+
+	c.SetRune(2, 0, 'X')
+	assert.Equal(t, "⢁X", c.String())
+}
+
+func TestASCIICanvas(t *testing.T) {
+	c := NewASCIICanvas(3, 2, '*')
+	w, h := c.DotSize()
+	assert.Equal(t, 3, w)
+	assert.Equal(t, 2, h)
+
+	c.Set(0, 0)
+	c.SetRune(2, 1, 'o')
+	c.Set(-1, 0)
+	assert.Equal(t, "*  \n  o", c.String())
+}
+
+func TestLine(t *testing.T) {
+	c := NewASCIICanvas(4, 4, '#')
+	Line(c, 0, 0, 3, 3)
+	assert.Equal(t, "#   \n #  \n  # \n   #", c.String())
+
+	c = NewASCIICanvas(4, 1, '#')
+	Line(c, 3, 0, 0, 0)
+	assert.Equal(t, "####", c.String())
+}
+
+func TestNewCanvas(t *testing.T) {
+	_, ok := NewCanvas(1, 1, true).(*ASCIICanvas)
+	assert.True(t, ok)
+	_, ok = NewCanvas(1, 1, false).(*BrailleCanvas)
+	assert.True(t, ok)
+}
@@ -0,0 +1,155 @@
+package game_detail
+
+import (
+	"sort"
+	"strings"
+
+	"nba-tui/internal/stats"
+	"nba-tui/internal/utils"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// noSort keeps the players in feed order.
+const noSort = -1
+
+// stepSortColumn moves the sort to the next (delta 1) or previous column,
+// passing through feed order. Stats start high to low, names A to Z.
+func (m Model) stepSortColumn(delta int) Model {
+	n := len(m.columns)
+	// Positions 0..n-1 are columns, n is feed order
+	pos := m.sortColumn
+	if pos == noSort {
+		pos = n
+	}
+	pos = (pos + delta + n + 1) % (n + 1)
+	if pos == n {
+		m.sortColumn = noSort
+	} else {
+		m.sortColumn = pos
+		m.sortDesc = m.columns[pos].name != "PLAYER"
+	}
+	m.boxOffset = 0
+	return m
+}
+
+// sortedPlayers returns the team's players in the selected sort order.
+// Players without stats stay at the bottom either way.
+func (m Model) sortedPlayers(team types.Team) []types.Player {
+	if team.Players == nil {
+		return nil
+	}
+	players := append([]types.Player(nil), *team.Players...)
+	if m.sortColumn == noSort || m.sortColumn >= len(m.columns) {
+		return players
+	}
+
+	column := m.columns[m.sortColumn].name
+	sort.SliceStable(players, func(i, j int) bool {
+		a, b := players[i], players[j]
+		if (a.Statistics == nil) != (b.Statistics == nil) {
+			return a.Statistics != nil
+		}
+		if column == "PLAYER" {
+			an := strings.ToLower(a.FamilyName + " " + a.FirstName)
+			bn := strings.ToLower(b.FamilyName + " " + b.FirstName)
+			if m.sortDesc {
+				return an > bn
+			}
+			return an < bn
+		}
+		av, aok := statValue(a, team, column)
+		bv, bok := statValue(b, team, column)
+		if aok != bok {
+			return aok
+		}
+		if m.sortDesc {
+			return av > bv
+		}
+		return av < bv
+	})
+	return players
+}
+
+// statValue returns the numeric value of a box score column for sorting.
+func statValue(p types.Player, team types.Team, column string) (float64, bool) {
+	if p.Statistics == nil {
+		return 0, false
+	}
+	line := stats.NewLine(p.Statistics.CommonBoxScoreStatistic)
+	switch column {
+	case "TS%":
+		return stats.TrueShooting(line)
+	case "eFG%":
+		return stats.EffectiveFG(line)
+	case "AST/TO":
+		return stats.AssistToTurnover(line)
+	case "GmSc":
+		return stats.GameScore(line), true
+	case "USG%":
+		var teamLine stats.Line
+		if team.Statistics != nil {
+			teamLine = stats.NewLine(team.Statistics.CommonBoxScoreStatistic)
+		}
+		return stats.Usage(line, teamLine)
+	}
+
+	box := p.Statistics
+	intVal := func(v *int) (float64, bool) {
+		if v == nil {
+			return 0, false
+		}
+		return float64(*v), true
+	}
+	floatVal := func(v *float64) (float64, bool) {
+		if v == nil {
+			return 0, false
+		}
+		return *v, true
+	}
+
+	switch column {
+	case "MIN":
+		seconds, ok := utils.ParseClock(box.Minutes)
+		return float64(seconds), ok
+	case "FGM":
+		return intVal(box.FgM)
+	case "FGA":
+		return intVal(box.FgA)
+	case "FG%":
+		return floatVal(box.FgPct)
+	case "3PM":
+		return intVal(box.Fg3M)
+	case "3PA":
+		return intVal(box.Fg3A)
+	case "3P%":
+		return floatVal(box.Fg3Pct)
+	case "FTM":
+		return intVal(box.FtM)
+	case "FTA":
+		return intVal(box.FtA)
+	case "FT%":
+		return floatVal(box.FtPct)
+	case "OREB":
+		return intVal(box.OReb)
+	case "DREB":
+		return intVal(box.DReb)
+	case "REB":
+		return intVal(box.Reb)
+	case "AST":
+		return intVal(box.Ast)
+	case "STL":
+		return intVal(box.Stl)
+	case "BLK":
+		return intVal(box.Blk)
+	case "TO":
+		return intVal(box.Tov)
+	case "PF":
+		return intVal(box.PF)
+	case "PTS":
+		return intVal(box.Pts)
+	case "+/-":
+		return floatVal(box.PlusMinus)
+	}
+	return 0, false
+}
@@ -0,0 +1,128 @@
+package game_detail
+
+import (
+	"strings"
+	"testing"
+
+	"nba-tui/internal/ui/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func sortTestModel() Model {
+	players := []types.Player{
+		{PersonID: 1, FirstName: "Austin", FamilyName: "Reaves", Statistics: &types.PlayerBoxScoreStatistic{
+			CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Minutes: "PT30M00.00S", Pts: ptr(12), FgPct: ptr(0.5)},
+			PlusMinus:               ptr(-3.0),
+		}},
+		{PersonID: 2, FirstName: "Bronny", FamilyName: "James"},
+		{PersonID: 3, FirstName: "LeBron", FamilyName: "James", Statistics: &types.PlayerBoxScoreStatistic{
+			CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Minutes: "PT36M00.00S", Pts: ptr(30), FgPct: ptr(0.6)},
+			PlusMinus:               ptr(8.0),
+		}},
+		{PersonID: 4, FirstName: "Gabe", FamilyName: "Vincent", Statistics: &types.PlayerBoxScoreStatistic{
+			CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Minutes: "PT12M00.00S", Pts: ptr(5)},
+			PlusMinus:               ptr(1.0),
+		}},
+	}
+	m := New(&mockNbaClient{}, "123", Config{Theme: styles.NoDecoration()})
+	m.width = 200
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{Game: types.Game{
+		GameId: "123",
+		HomeTeam: types.Team{
+			TeamId: 1, TeamTricode: "LAL", Players: &players,
+			Statistics: &types.TeamBoxScoreStatistic{CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Pts: ptr(47)}},
+		},
+	}}
+	return m
+}
+
+func sortedIDs(m Model) []int {
+	var ids []int
+	for _, p := range m.sortedPlayers(m.getCurrentTeam()) {
+		ids = append(ids, p.PersonID)
+	}
+	return ids
+}
+
+func columnIndex(name string) int {
+	for i, c := range sortTestModel().columns {
+		if c.name == name {
+			return i
+		}
+	}
+	return noSort
+}
+
+func TestSortedPlayers(t *testing.T) {
+	m := sortTestModel()
+	assert.Equal(t, []int{1, 2, 3, 4}, sortedIDs(m), "feed order by default")
+
+	tests := []struct {
+		column string
+		desc   bool
+		want   []int
+	}{
+		{"PTS", true, []int{3, 1, 4, 2}},
+		{"PTS", false, []int{4, 1, 3, 2}},
+		{"MIN", true, []int{3, 1, 4, 2}},
+		{"+/-", false, []int{1, 4, 3, 2}},
+		// Missing values sort after present ones
+		{"FG%", true, []int{3, 1, 4, 2}},
+		{"PLAYER", false, []int{3, 1, 4, 2}},
+	}
+	for _, tt := range tests {
+		m.sortColumn = columnIndex(tt.column)
+		m.sortDesc = tt.desc
+		assert.Equal(t, tt.want, sortedIDs(m), "%s desc=%v", tt.column, tt.desc)
+	}
+}
+
+func TestUpdate_SortKeys(t *testing.T) {
+	m := sortTestModel()
+	m.boxOffset = 2
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m = updated.(Model)
+	assert.Equal(t, 0, m.sortColumn)
+	assert.False(t, m.sortDesc, "names start A to Z")
+	assert.Equal(t, 0, m.boxOffset)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m = updated.(Model)
+	assert.Equal(t, 1, m.sortColumn)
+	assert.True(t, m.sortDesc, "stats start high to low")
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	m = updated.(Model)
+	assert.False(t, m.sortDesc)
+
+	// Stepping back past the first column returns to feed order, then wraps
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	m = updated.(Model)
+	assert.Equal(t, noSort, m.sortColumn)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	assert.Equal(t, len(m.columns)-1, updated.(Model).sortColumn)
+}
+
+func TestBoxScoreSortedView(t *testing.T) {
+	m := sortTestModel()
+	m.sortColumn = columnIndex("PTS")
+	m.sortDesc = true
+
+	view := stripANSI(m.View())
+	assert.Contains(t, view, "PTS▼")
+	assert.Less(t, strings.Index(view, "L.James"), strings.Index(view, "A.Reaves"))
+	assert.Less(t, strings.Index(view, "G.Vincent"), strings.Index(view, "B.James"))
+	// TOTAL stays pinned below the players
+	assert.Less(t, strings.Index(view, "B.James"), strings.Index(view, "TOTAL"))
+
+	// The top row follows the sort for the drill-down
+	m.focus = boxScoreFocus
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, SelectPlayerMsg{PersonID: 3}, cmd())
+}
@@ -1,6 +1,8 @@
 package game_detail
 
 import (
+	"nba-tui/internal/ui/styles"
+
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
 	"github.com/poteto0/go-nba-sdk/types"
@@ -46,7 +48,7 @@ func TestBoxScoreDecoration(t *testing.T) {
 		},
 	}
 
-	m := New(client, "123", Config{NoDecoration: false})
+	m := New(client, "123", Config{})
 	m.width = 200
 	m.height = 40
 	m.boxScore = types.LiveBoxScoreResponse{
@@ -74,8 +76,42 @@ func TestBoxScoreDecoration(t *testing.T) {
 
 	// Decoration check
 	t.Run("NoDecoration true", func(t *testing.T) {
-		m.config.NoDecoration = true
+		m.config.Theme = styles.NoDecoration()
 		viewNoDeco := m.View()
 		assert.NotEqual(t, view, viewNoDeco, "View with and without decoration should be different")
 	})
 }
+
+func TestBoxScoreTheme(t *testing.T) {
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(termenv.Ascii)
+
+	pts, pm1, pm2 := 10, 5.0, -3.0
+	players := []types.Player{
+		{FamilyName: "Plus", Statistics: &types.PlayerBoxScoreStatistic{
+			CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Pts: &pts}, PlusMinus: &pm1}},
+		{FamilyName: "Minus", Statistics: &types.PlayerBoxScoreStatistic{
+			CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Pts: &pts}, PlusMinus: &pm2}},
+	}
+	render := func(theme styles.Theme) string {
+		m := New(&mockNbaClient{}, "123", Config{Theme: theme})
+		m.width, m.height = 200, 40
+		m.boxScore = types.LiveBoxScoreResponse{Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamTricode: "LAL", Players: &players},
+		}}
+		return m.View()
+	}
+
+	// Okabe-Ito blue and orange instead of green and red
+	theme := styles.ColorBlindSafe()
+	view := render(theme)
+	assert.Contains(t, view, theme.Positive.Render("5"))
+	assert.Contains(t, view, theme.Negative.Render("-3"))
+	assert.NotContains(t, view, styles.Default().Positive.Render("5"))
+
+	// Monochrome sets no colors at all
+	view = render(styles.Monochrome())
+	assert.NotContains(t, view, "\x1b[38;")
+	assert.Contains(t, view, "║") // focused box score
+}
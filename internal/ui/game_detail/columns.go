@@ -0,0 +1,178 @@
+package game_detail
+
+import (
+	"fmt"
+	"strings"
+
+	"nba-tui/internal/export"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type boxColumn struct {
+	name  string
+	width int
+	align lipgloss.Position
+}
+
+// columnWidths are the widths of the box score columns in the detail view.
+var columnWidths = map[string]int{
+	"PLAYER": 15, "MIN": 5,
+	"FGM": 3, "FGA": 3, "FG%": 5, "3PM": 3, "3PA": 3, "3P%": 5, "FTM": 3, "FTA": 3, "FT%": 5,
+	"OREB": 4, "DREB": 4, "REB": 3, "AST": 3, "STL": 3, "BLK": 3, "TO": 3, "PF": 3, "PTS": 3, "+/-": 4,
+	"TS%": 5, "eFG%": 5, "AST/TO": 6, "GmSc": 5, "USG%": 5,
+}
+
+// boxColumns are all box score columns, the full preset followed by
+// the advanced stats. Rows are built in this order, the one of exports.
+var boxColumns = newBoxColumns(export.Columns())
+
+func newBoxColumns(names []string) []boxColumn {
+	columns := make([]boxColumn, 0, len(names))
+	for _, name := range names {
+		align := lipgloss.Right
+		if name == "PLAYER" || name == "MIN" {
+			align = lipgloss.Left
+		}
+		columns = append(columns, boxColumn{name, columnWidths[name], align})
+	}
+	return columns
+}
+
+// ColumnPresets are the built-in box score column sets.
+// PLAYER is always shown first and is left out of the lists.
+var ColumnPresets = map[string][]string{
+	"basic":    {"MIN", "PTS", "REB", "AST", "STL", "BLK", "TO", "PF", "+/-"},
+	"shooting": {"MIN", "FGM", "FGA", "FG%", "3PM", "3PA", "3P%", "FTM", "FTA", "FT%", "PTS"},
+	"full": {"MIN", "FGM", "FGA", "FG%", "3PM", "3PA", "3P%", "FTM", "FTA", "FT%",
+		"OREB", "DREB", "REB", "AST", "STL", "BLK", "TO", "PF", "PTS", "+/-"},
+	"advanced": {"MIN", "PTS", "TS%", "eFG%", "USG%", "GmSc", "AST", "TO", "AST/TO", "+/-"},
+}
+
+// presetOrder is the order <v> cycles through the presets.
+var presetOrder = []string{"basic", "shooting", "full", "advanced"}
+
+const (
+	defaultPreset = "full"
+	customPreset  = "custom"
+)
+
+// lookupColumns returns the columns for names, with PLAYER pinned first.
+func lookupColumns(names []string) ([]boxColumn, error) {
+	columns := []boxColumn{boxColumns[0]}
+	seen := map[string]bool{"PLAYER": true}
+	for _, name := range names {
+		col, ok := findColumn(strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown box score column %q", name)
+		}
+		if seen[col.name] {
+			continue
+		}
+		seen[col.name] = true
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+func findColumn(name string) (boxColumn, bool) {
+	for _, c := range boxColumns {
+		if strings.EqualFold(c.name, name) {
+			return c, true
+		}
+	}
+	return boxColumn{}, false
+}
+
+// ValidateColumns checks a preset name and a custom column list.
+// Custom columns take precedence over the preset when both are set.
+func ValidateColumns(preset string, columns []string) error {
+	if len(columns) > 0 {
+		_, err := lookupColumns(columns)
+		return err
+	}
+	if preset == "" {
+		return nil
+	}
+	if _, ok := ColumnPresets[preset]; !ok {
+		return fmt.Errorf("unknown column preset %q (want %s)", preset, strings.Join(presetOrder, "|"))
+	}
+	return nil
+}
+
+// initialColumns resolves the configured column set, falling back to full.
+func initialColumns(config Config) (string, []boxColumn) {
+	if len(config.Columns) > 0 {
+		if columns, err := lookupColumns(config.Columns); err == nil {
+			return customPreset, columns
+		}
+	}
+	if names, ok := ColumnPresets[config.ColumnPreset]; ok {
+		columns, _ := lookupColumns(names)
+		return config.ColumnPreset, columns
+	}
+	columns, _ := lookupColumns(ColumnPresets[defaultPreset])
+	return defaultPreset, columns
+}
+
+// stepPreset switches to the next column set, custom columns included
+// when configured. The sort is kept when its column is still shown.
+func (m Model) stepPreset() Model {
+	cycle := presetOrder
+	if len(m.config.Columns) > 0 {
+		cycle = append([]string{customPreset}, presetOrder...)
+	}
+	next := cycle[0]
+	for i, name := range cycle {
+		if name == m.preset {
+			next = cycle[(i+1)%len(cycle)]
+			break
+		}
+	}
+
+	sortName := ""
+	if m.sortColumn != noSort {
+		sortName = m.columns[m.sortColumn].name
+	}
+
+	m.preset = next
+	if next == customPreset {
+		m.columns, _ = lookupColumns(m.config.Columns)
+	} else {
+		m.columns, _ = lookupColumns(ColumnPresets[next])
+	}
+
+	m.sortColumn = noSort
+	for i, c := range m.columns {
+		if c.name == sortName {
+			m.sortColumn = i
+		}
+	}
+	m.boxScrollX = 0
+	return m
+}
+
+// visibleColumns returns the active columns with the sort indicator applied.
+func (m Model) visibleColumns() []boxColumn {
+	cols := append([]boxColumn(nil), m.columns...)
+	if m.sortColumn != noSort && m.sortColumn < len(cols) {
+		// Widen the sorted column so the indicator fits
+		indicator := "▲"
+		if m.sortDesc {
+			indicator = "▼"
+		}
+		cols[m.sortColumn].name += indicator
+		cols[m.sortColumn].width++
+	}
+	return cols
+}
+
+// boxScoreWidth is the width of a full box score row of the visible columns.
+func (m Model) boxScoreWidth() int {
+	cols := m.visibleColumns()
+	width := len(cols) - 1 // separators
+	for _, c := range cols {
+		width += c.width
+	}
+	return width
+}
@@ -0,0 +1,149 @@
+package game_detail
+
+import (
+	"fmt"
+	"testing"
+
+	"nba-tui/internal/export"
+	"nba-tui/internal/ui/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func columnNames(columns []boxColumn) []string {
+	names := make([]string, 0, len(columns))
+	for _, c := range columns {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+func TestBoxColumns(t *testing.T) {
+	assert.Equal(t, export.Columns(), columnNames(boxColumns))
+	for _, c := range boxColumns {
+		assert.Positive(t, c.width, c.name)
+	}
+}
+
+func TestValidateColumns(t *testing.T) {
+	assert.NoError(t, ValidateColumns("", nil))
+	assert.NoError(t, ValidateColumns("shooting", nil))
+	assert.NoError(t, ValidateColumns("", []string{"pts", "+/-"}))
+	assert.ErrorContains(t, ValidateColumns("fancy", nil), `unknown column preset "fancy"`)
+	assert.ErrorContains(t, ValidateColumns("basic", []string{"PTS", "XYZ"}), `unknown box score column "XYZ"`)
+}
+
+func TestInitialColumns(t *testing.T) {
+	preset, columns := initialColumns(Config{})
+	assert.Equal(t, "full", preset)
+	assert.Len(t, columns, 21)
+
+	preset, columns = initialColumns(Config{ColumnPreset: "basic"})
+	assert.Equal(t, "basic", preset)
+	assert.Equal(t, []string{"PLAYER", "MIN", "PTS", "REB", "AST", "STL", "BLK", "TO", "PF", "+/-"}, columnNames(columns))
+
+	// Custom columns keep their order, PLAYER stays first
+	preset, columns = initialColumns(Config{ColumnPreset: "basic", Columns: []string{"pts", "PLAYER", "+/-", "PTS", "min"}})
+	assert.Equal(t, "custom", preset)
+	assert.Equal(t, []string{"PLAYER", "PTS", "+/-", "MIN"}, columnNames(columns))
+}
+
+func TestUpdate_CyclePresets(t *testing.T) {
+	m := New(&mockNbaClient{}, "123", Config{Columns: []string{"PTS", "AST"}})
+	assert.Equal(t, "custom", m.preset)
+
+	m.sortColumn = 1 // PTS
+	var presets []string
+	for i := 0; i < 5; i++ {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+		m = updated.(Model)
+		presets = append(presets, m.preset)
+		if m.preset == "basic" {
+			assert.Equal(t, "PTS", m.columns[m.sortColumn].name, "sort follows the column")
+		}
+	}
+	assert.Equal(t, []string{"basic", "shooting", "full", "advanced", "custom"}, presets)
+	assert.Equal(t, "Columns: custom", m.statusMsg)
+}
+
+func TestBoxScorePresetView(t *testing.T) {
+	players := []types.Player{{FamilyName: "Preset", Statistics: &types.PlayerBoxScoreStatistic{}}}
+	m := New(&mockNbaClient{}, "123", Config{ColumnPreset: "basic"})
+	m.width = 200
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{Game: types.Game{
+		GameId:   "123",
+		HomeTeam: types.Team{TeamTricode: "LAL", Players: &players},
+	}}
+
+	view := stripANSI(m.View())
+	assert.Contains(t, view, "PTS")
+	assert.NotContains(t, view, "FGM")
+	assert.NotContains(t, view, "OREB")
+}
+
+func TestBoxScoreScrollLimitFollowsColumns(t *testing.T) {
+	for _, preset := range presetOrder {
+		m := New(&mockNbaClient{}, "123", Config{ColumnPreset: preset})
+		m.width = 40
+		m.height = 40
+		m.focus = boxScoreFocus
+
+		updated := tea.Model(m)
+		for i := 0; i < 500; i++ {
+			updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRight})
+		}
+		want := max(m.boxScoreWidth()-(m.width-2), 0)
+		assert.Equal(t, want, updated.(Model).boxScrollX, preset)
+	}
+}
+
+func TestBoxScoreAdvancedColumns(t *testing.T) {
+	players := []types.Player{
+		{FirstName: "Bench", FamilyName: "Guy", Statistics: &types.PlayerBoxScoreStatistic{
+			CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Minutes: "PT10M00.00S", Pts: ptr(2), FgM: ptr(1), FgA: ptr(4)},
+		}},
+		{FirstName: "Star", FamilyName: "Player", Statistics: &types.PlayerBoxScoreStatistic{
+			CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Minutes: "PT30M00.00S", Pts: ptr(20), FgM: ptr(8), FgA: ptr(16), Fg3M: ptr(4)},
+		}},
+	}
+	m := New(&mockNbaClient{}, "123", Config{ColumnPreset: "advanced", Theme: styles.NoDecoration()})
+	m.width = 200
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{Game: types.Game{
+		GameId: "123",
+		HomeTeam: types.Team{
+			TeamTricode: "LAL", Players: &players,
+			Statistics: &types.TeamBoxScoreStatistic{CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
+				Minutes: "PT240M00.00S", Pts: ptr(22), FgM: ptr(9), FgA: ptr(20), Fg3M: ptr(4),
+			}},
+		},
+	}}
+
+	view := stripANSI(m.View())
+	for _, col := range []string{"TS%", "eFG%", "USG%", "GmSc", "AST/TO"} {
+		assert.Contains(t, view, col)
+	}
+	assert.Contains(t, view, "62.5") // Star eFG%
+
+	// Sorting by game score puts the star first
+	for m.sortColumn == noSort || m.columns[m.sortColumn].name != "GmSc" {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		m = updated.(Model)
+	}
+	assert.Equal(t, "Star", m.sortedPlayers(m.getCurrentTeam())[0].FirstName)
+}
+
+func TestScrollLineKeepsSortedPlayerColumn(t *testing.T) {
+	m := New(&mockNbaClient{}, "123", Config{ColumnPreset: "basic"})
+	m.boxScrollX = 4
+	for _, sortColumn := range []int{noSort, 0} {
+		m.sortColumn = sortColumn
+		// The sort indicator widens PLAYER
+		player := m.visibleColumns()[0].width
+		line := fmt.Sprintf("%-*s|%s", player, "PLAYER", "abcdefghijkl")
+		assert.Equal(t, line[:player+1]+"efgh", m.scrollLine(line, player+5), sortColumn)
+	}
+}
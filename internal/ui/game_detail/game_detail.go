@@ -1,13 +1,20 @@
 package game_detail
 
 import (
+	"bytes"
 	"fmt"
+	"nba-tui/internal/export"
+	"nba-tui/internal/stats"
+	"nba-tui/internal/ui/keys"
 	"nba-tui/internal/ui/styles"
 	"nba-tui/internal/utils"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -22,14 +29,41 @@ const (
 	gameLogFocus
 )
 
+// panel is what the right-hand pane shows.
+type panel int
+
+const (
+	gameLogPanel panel = iota
+	scoreFlowPanel
+	shotChartPanel
+	comparePanel
+)
+
+// togglePanel switches to p, or back to the game log when p is shown.
+func (m Model) togglePanel(p panel) panel {
+	if m.panel == p {
+		return gameLogPanel
+	}
+	return p
+}
+
+// SelectPlayerMsg asks the parent to open the detail of a player.
+type SelectPlayerMsg struct {
+	PersonID int
+}
+
 type NbaClient interface {
 	GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error)
 	GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error)
 }
 
 type Config struct {
-	NoDecoration bool
+	Theme        styles.Theme // styles.Default() when unset
 	KawaiiMode   bool
+	ExportFormat export.Format // csv, json or md; csv when empty
+	ExportDir    string        // current directory when empty
+	ColumnPreset string        // box score column preset; full when empty
+	Columns      []string      // custom box score columns, overrides ColumnPreset
 }
 
 type Model struct {
@@ -44,9 +78,18 @@ type Model struct {
 	boxOffset         int
 	boxScrollX        int
 	selectedPeriod    int
+	preset            string
+	columns           []boxColumn
+	sortColumn        int // index into columns, noSort for feed order
+	sortDesc          bool
+	logMode           logMode
+	panel             panel
+	shotPlayerID      int // shot chart of a single player when set
 	width             int
 	height            int
 	OpenBrowser       func(string) error
+	WriteFile         func(name string, data []byte) error
+	Keys              keys.KeyMap
 	config            Config
 	searchInput       textinput.Model
 	searchMode        bool
@@ -54,6 +97,7 @@ type Model struct {
 	currentMatchIndex int
 	retryCount        int
 	errMsg            string
+	statusMsg         string
 }
 
 func New(client NbaClient, gameID string, config Config) Model {
@@ -63,14 +107,26 @@ func New(client NbaClient, gameID string, config Config) Model {
 	ti.CharLimit = 156
 	ti.Width = 30
 
+	preset, columns := initialColumns(config)
+	if config.Theme.Name == "" {
+		config.Theme = styles.Default()
+	}
+
 	return Model{
 		client:         client,
 		gameID:         gameID,
 		showingHome:    true,
 		selectedPeriod: 1,
+		sortColumn:     noSort,
+		preset:         preset,
+		columns:        columns,
 		OpenBrowser: func(url string) error {
 			return exec.Command("xdg-open", url).Start()
 		},
+		WriteFile: func(name string, data []byte) error {
+			return os.WriteFile(name, data, 0o600)
+		},
+		Keys:        keys.Default(),
 		config:      config,
 		searchInput: ti,
 	}
@@ -80,6 +136,11 @@ func (m *Model) SetLastUpdated(t time.Time) {
 	m.lastUpdated = t
 }
 
+// SetStatus shows msg above the footer, or nothing when it is empty.
+func (m *Model) SetStatus(msg string) {
+	m.statusMsg = msg
+}
+
 func (m Model) IsShowingHome() bool {
 	return m.showingHome
 }
@@ -100,6 +161,23 @@ func (m Model) GetSelectedPeriod() int {
 	return m.selectedPeriod
 }
 
+// Searching reports whether the search input has the keyboard.
+func (m Model) Searching() bool {
+	return m.searchMode
+}
+
+func (m Model) IsShowingAllTeams() bool {
+	return m.logMode == allLogMode
+}
+
+func (m Model) BoxScore() types.LiveBoxScoreResponse {
+	return m.boxScore
+}
+
+func (m Model) PlayByPlay() types.LivePlayByPlayResponse {
+	return m.pbp
+}
+
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.fetchBoxScore,
@@ -131,6 +209,30 @@ func (m Model) fetchPlayByPlay() tea.Msg {
 	return PlayByPlayMsg(res)
 }
 
+type exportedMsg struct {
+	path string
+	err  error
+}
+
+// exportBoxScore writes the current box score of both teams to a file.
+func (m Model) exportBoxScore() tea.Cmd {
+	format := m.config.ExportFormat
+	if format == "" {
+		format = export.FormatCSV
+	}
+	path := filepath.Join(m.config.ExportDir, export.BoxScoreFileName(m.gameID, format))
+	game := m.boxScore.Game
+	write := m.WriteFile
+
+	return func() tea.Msg {
+		var buf bytes.Buffer
+		if err := export.WriteBoxScore(&buf, game, format); err != nil {
+			return exportedMsg{path: path, err: err}
+		}
+		return exportedMsg{path: path, err: write(path, buf.Bytes())}
+	}
+}
+
 type BoxScoreMsg types.LiveBoxScoreResponse
 type PlayByPlayMsg types.LivePlayByPlayResponse
 type ErrorMsg error
@@ -146,7 +248,10 @@ func (m Model) getVisibleActions() []types.Action {
 	team := m.getCurrentTeam()
 	var filteredActions []types.Action
 	for _, action := range m.pbp.Game.Actions {
-		if action.Period == m.selectedPeriod && action.TeamID == team.TeamId {
+		if m.selectedPeriod != AllPeriods && action.Period != m.selectedPeriod {
+			continue
+		}
+		if m.logMode == allLogMode || action.TeamID == team.TeamId {
 			filteredActions = append(filteredActions, action)
 		}
 	}
@@ -175,6 +280,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.searchInput.Blur()
 				return m, nil
 			}
+			// The search input owns every other key
+			var cmd tea.Cmd
+			m.searchInput, cmd = m.searchInput.Update(msg)
+			return m, cmd
 		default:
 			var cmd tea.Cmd
 			m.searchInput, cmd = m.searchInput.Update(msg)
@@ -197,6 +306,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.pbp = types.LivePlayByPlayResponse(msg)
 		m.lastUpdated = time.Now()
 
+	case exportedMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Export failed: %v", msg.err)
+		} else {
+			m.statusMsg = fmt.Sprintf("Exported: %s", msg.path)
+		}
+		return m, nil
+
 	case boxScoreErrMsg:
 		if m.boxScore.Game.GameId == "" {
 			m.retryCount++
@@ -209,13 +326,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		team := m.getCurrentTeam()
-		switch msg.String() {
-		case "/":
+		switch {
+		case key.Matches(msg, m.Keys.Search):
 			m.searchMode = true
 			m.searchInput.Focus()
 			m.searchInput.SetValue("")
 			return m, nil
-		case "n":
+		case key.Matches(msg, m.Keys.NextMatch):
 			if len(m.matchedIndices) > 0 {
 				m.currentMatchIndex++
 				if m.currentMatchIndex >= len(m.matchedIndices) {
@@ -224,7 +341,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.logOffset = m.matchedIndices[m.currentMatchIndex]
 				m.focus = gameLogFocus
 			}
-		case "N":
+		case key.Matches(msg, m.Keys.PrevMatch):
 			if len(m.matchedIndices) > 0 {
 				m.currentMatchIndex--
 				if m.currentMatchIndex < 0 {
@@ -233,49 +350,90 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.logOffset = m.matchedIndices[m.currentMatchIndex]
 				m.focus = gameLogFocus
 			}
-		case "ctrl+s":
+		case key.Matches(msg, m.Keys.SwitchTeam):
 			m.showingHome = !m.showingHome
+			m.shotPlayerID = 0
 			m.logOffset = 0
 			m.matchedIndices = []int{}
 			m.currentMatchIndex = 0
-		case "ctrl+c":
-			return m, tea.Quit
-		case "ctrl+q":
-			m.selectedPeriod++
-			if m.selectedPeriod > 4 {
-				m.selectedPeriod = 1
+		case key.Matches(msg, m.Keys.ScoreFlow):
+			m.panel = m.togglePanel(scoreFlowPanel)
+		case key.Matches(msg, m.Keys.Compare):
+			m.panel = m.togglePanel(comparePanel)
+		case key.Matches(msg, m.Keys.ShotChart):
+			// From the box score, chart the player on the top row. A chart
+			// of someone else is switched over instead of closed.
+			playerID := 0
+			if players := m.sortedPlayers(team); m.focus == boxScoreFocus && m.boxOffset < len(players) {
+				playerID = players[m.boxOffset].PersonID
+			}
+			if m.panel != shotChartPanel || m.shotPlayerID == playerID {
+				m.panel = m.togglePanel(shotChartPanel)
+			}
+			m.shotPlayerID = playerID
+		case key.Matches(msg, m.Keys.LogMode):
+			if m.logMode == allLogMode {
+				m.logMode = teamLogMode
+			} else {
+				m.logMode = allLogMode
 			}
 			m.logOffset = 0
 			m.matchedIndices = []int{}
 			m.currentMatchIndex = 0
-		case "ctrl+w":
+		case key.Matches(msg, m.Keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, m.Keys.NextPeriod):
+			m = m.stepPeriod(1)
+		case key.Matches(msg, m.Keys.PrevPeriod):
+			m = m.stepPeriod(-1)
+		case key.Matches(msg, m.Keys.Watch):
 			url := fmt.Sprintf("https://www.nba.com/game/%s", m.gameID)
 			if m.OpenBrowser != nil {
 				_ = m.OpenBrowser(url)
 			}
-		case "ctrl+b":
+		case key.Matches(msg, m.Keys.Export):
+			if m.boxScore.Game.GameId != "" && m.WriteFile != nil {
+				return m, m.exportBoxScore()
+			}
+		case key.Matches(msg, m.Keys.Player):
+			// Drill down into the player on the top row of the box score
+			if players := m.sortedPlayers(team); m.focus == boxScoreFocus && m.boxOffset < len(players) {
+				personID := players[m.boxOffset].PersonID
+				return m, func() tea.Msg {
+					return SelectPlayerMsg{PersonID: personID}
+				}
+			}
+		case key.Matches(msg, m.Keys.SortNext):
+			m = m.stepSortColumn(1)
+		case key.Matches(msg, m.Keys.SortPrev):
+			m = m.stepSortColumn(-1)
+		case key.Matches(msg, m.Keys.Columns):
+			m = m.stepPreset()
+			m.statusMsg = fmt.Sprintf("Columns: %s", m.preset)
+		case key.Matches(msg, m.Keys.SortReverse):
+			if m.sortColumn != noSort {
+				m.sortDesc = !m.sortDesc
+				m.boxOffset = 0
+			}
+		case key.Matches(msg, m.Keys.FocusBox):
 			m.focus = boxScoreFocus
-		case "ctrl+l":
+		case key.Matches(msg, m.Keys.FocusLog):
 			m.focus = gameLogFocus
-		case "h", "left":
+		case key.Matches(msg, m.Keys.Left):
 			if m.focus == boxScoreFocus {
 				if m.boxScrollX > 0 {
 					m.boxScrollX--
 				}
 			}
-		case "l", "right":
+		case key.Matches(msg, m.Keys.Right):
 			if m.focus == boxScoreFocus {
-				headerFormat := "%-15s %-5s %3s %3s %5s %3s %3s %5s %3s %3s %5s %4s %4s %3s %3s %3s %3s %3s %3s %3s %4s"
-				fullHeader := fmt.Sprintf(headerFormat,
-					"PLAYER", "MIN", "FGM", "FGA", "FG%", "3PM", "3PA", "3P%", "FTM", "FTA", "FT%", "OREB", "DREB", "REB", "AST", "STL", "BLK", "TO", "PF", "PTS", "+/-")
-
 				w_boxscore := (m.width * 6) / 10
 				if m.width < 100 {
 					w_boxscore = m.width
 				}
 				contentWidth := w_boxscore - 2
 
-				maxScroll := len(fullHeader) - contentWidth
+				maxScroll := m.boxScoreWidth() - contentWidth
 				if maxScroll < 0 {
 					maxScroll = 0
 				}
@@ -284,7 +442,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.boxScrollX++
 				}
 			}
-		case "j", "down":
+		case key.Matches(msg, m.Keys.Down):
 			if m.focus == boxScoreFocus {
 				if team.Players != nil {
 					players := *team.Players
@@ -298,7 +456,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.logOffset++
 				}
 			}
-		case "k", "up":
+		case key.Matches(msg, m.Keys.Up):
 			if m.focus == boxScoreFocus {
 				if m.boxOffset > 0 {
 					m.boxOffset--
@@ -334,7 +492,7 @@ func (m Model) View() string {
 		updateTimeStr := m.lastUpdated.Format("15:04:05") // HH:MM:SS
 		teamInfo = fmt.Sprintf("%s (Last Updated: %s)", teamInfo, updateTimeStr)
 	}
-	selectedTeamView := styles.UnderlineStyle.Render(teamInfo)
+	selectedTeamView := m.config.Theme.Underline.Render(teamInfo)
 
 	// Render footer first to know its height
 	var footerView string
@@ -384,23 +542,23 @@ func (m Model) View() string {
 
 		if h_main >= 4 {
 			bsContent := m.renderBoxScore(team, w_boxscore-2, h_main-2)
-			bsStyle := styles.BorderStyle
+			bsStyle := m.config.Theme.Border
 			if m.focus == boxScoreFocus {
-				bsStyle = styles.ActiveBorderStyle
+				bsStyle = m.config.Theme.ActiveBorder
 			}
 			boxScore := bsStyle.Width(w_boxscore).Height(h_main).MaxHeight(h_main).Render(bsContent)
 
 			glContent := m.renderGameLog(w_gamelog-2, h_main-2)
-			glStyle := styles.BorderStyle
+			glStyle := m.config.Theme.Border
 			if m.focus == gameLogFocus {
-				glStyle = styles.ActiveBorderStyle
+				glStyle = m.config.Theme.ActiveBorder
 			}
 			gameLog := glStyle.Width(w_gamelog).Height(h_main).MaxHeight(h_main).Render(glContent)
 
 			mainView = lipgloss.JoinHorizontal(lipgloss.Top, boxScore, gameLog)
 		}
 
-		headerBox = styles.BorderStyle.Width(m.width).Height(h_header_box).MaxHeight(h_header_box).Align(lipgloss.Center, lipgloss.Center).Render(headerStr)
+		headerBox = m.headerBorder().Width(m.width).Height(h_header_box).MaxHeight(h_header_box).Align(lipgloss.Center, lipgloss.Center).Render(headerStr)
 	} else {
 		// Vertical Layout: heights 4:4 split of h_main
 		if h_main >= 6 {
@@ -408,23 +566,23 @@ func (m Model) View() string {
 			h_gamelog := h_main - h_boxscore
 
 			bsContent := m.renderBoxScore(team, m.width-2, h_boxscore-2)
-			bsStyle := styles.BorderStyle
+			bsStyle := m.config.Theme.Border
 			if m.focus == boxScoreFocus {
-				bsStyle = styles.ActiveBorderStyle
+				bsStyle = m.config.Theme.ActiveBorder
 			}
 			boxScore := bsStyle.Width(m.width).Height(h_boxscore).MaxHeight(h_boxscore).Render(bsContent)
 
 			glContent := m.renderGameLog(m.width-2, h_gamelog-2)
-			glStyle := styles.BorderStyle
+			glStyle := m.config.Theme.Border
 			if m.focus == gameLogFocus {
-				glStyle = styles.ActiveBorderStyle
+				glStyle = m.config.Theme.ActiveBorder
 			}
 			gameLog := glStyle.Width(m.width).Height(h_gamelog).MaxHeight(h_gamelog).Render(glContent)
 
 			mainView = lipgloss.JoinVertical(lipgloss.Left, boxScore, gameLog)
 		}
 
-		headerBox = styles.BorderStyle.Width(m.width).Height(h_header_box).MaxHeight(h_header_box).Align(lipgloss.Center, lipgloss.Center).Render(headerStr)
+		headerBox = m.headerBorder().Width(m.width).Height(h_header_box).MaxHeight(h_header_box).Align(lipgloss.Center, lipgloss.Center).Render(headerStr)
 	}
 
 	if mainView == "" {
@@ -433,40 +591,69 @@ func (m Model) View() string {
 	return lipgloss.JoinVertical(lipgloss.Left, selectedTeamView, headerBox, mainView, footerView)
 }
 
+// headerBorder tints the score header with the home team's color.
+func (m Model) headerBorder() lipgloss.Style {
+	return m.config.Theme.TeamBorder(m.config.Theme.Border, m.boxScore.Game.HomeTeam.TeamTricode)
+}
+
 func (m Model) renderHeaderStr() string {
 	game := m.boxScore.Game
 	var status string
 
 	status = utils.RenderGameStatus(game)
 
-	homeTricode := game.HomeTeam.TeamTricode
+	homeStyle := m.config.Theme.Team(game.HomeTeam.TeamTricode)
 	homeScore := fmt.Sprintf("%d", game.HomeTeam.Score)
-	awayTricode := game.AwayTeam.TeamTricode
+	awayStyle := m.config.Theme.Team(game.AwayTeam.TeamTricode)
 	awayScore := fmt.Sprintf("%d", game.AwayTeam.Score)
 
 	if game.HomeTeam.Score > game.AwayTeam.Score {
-		homeTricode = styles.BoldStyle.Render(homeTricode)
-		homeScore = styles.BoldStyle.Render(homeScore)
+		homeStyle = homeStyle.Inherit(m.config.Theme.Bold)
+		homeScore = m.config.Theme.Bold.Render(homeScore)
 	} else if game.AwayTeam.Score > game.HomeTeam.Score {
-		awayTricode = styles.BoldStyle.Render(awayTricode)
-		awayScore = styles.BoldStyle.Render(awayScore)
+		awayStyle = awayStyle.Inherit(m.config.Theme.Bold)
+		awayScore = m.config.Theme.Bold.Render(awayScore)
 	}
+	homeTricode := homeStyle.Render(game.HomeTeam.TeamTricode)
+	awayTricode := awayStyle.Render(game.AwayTeam.TeamTricode)
 
-	return fmt.Sprintf("%s\n%s (%s) | %s (%s)",
+	scoreStr := fmt.Sprintf("%s\n%s (%s) | %s (%s)",
 		status,
 		homeTricode, homeScore,
 		awayTricode, awayScore,
 	)
+
+	// Line score next to the score once the game has started and there is room
+	if !game.IsGameStart() || m.width < 80 {
+		return scoreStr
+	}
+	lineScore := BuildLineScore(game, m.pbp.Game.Actions).Render(
+		game.HomeTeam.TeamTricode, game.AwayTeam.TeamTricode,
+		game.HomeTeam.Score, game.AwayTeam.Score,
+		game.Period,
+	)
+	scoreBlock := lipgloss.NewStyle().Align(lipgloss.Center).Render(scoreStr)
+	return lipgloss.JoinHorizontal(lipgloss.Center, scoreBlock, "    ", lineScore)
 }
 
 func (m Model) renderFooter(width int) string {
-	helpText := "<hjkli←↓↑→ >: move, <ctrl+s>: switch team, <ctrl+b>: box, <ctrl+l>: log, <ctrl+q>: period, <ctrl+w>: watch, <ctrl+c>: quit"
+	k := m.Keys
+	helpText := keys.Join(
+		keys.Footer(k.Move, k.SwitchTeam, k.FocusBox, k.FocusLog, k.Period, k.LogMode, k.ScoreFlow, k.ShotChart, k.Compare, k.Player),
+		keys.Hint("sort", k.SortNext, k.SortPrev, k.SortReverse),
+		keys.Footer(k.Columns),
+		keys.Hint("watch", k.Watch),
+		keys.Footer(k.Export, k.Quit, k.Help),
+	)
 	var footerText string
 	if !m.lastUpdated.IsZero() {
 		footerText = fmt.Sprintf("Last updated: %s\n%s", m.lastUpdated.Format(time.RFC1123), helpText)
 	} else {
 		footerText = helpText
 	}
+	if m.statusMsg != "" {
+		footerText = m.statusMsg + "\n" + footerText
+	}
 	// Truncate footer if it's too wide to prevent wrapping
 	if len(footerText) > width {
 		// Very basic truncation for safety
@@ -482,24 +669,35 @@ func (m Model) renderFooter(width int) string {
 }
 
 func (m Model) renderGameLog(width, height int) string {
+	switch m.panel {
+	case scoreFlowPanel:
+		return m.renderScoreFlow(width, height)
+	case shotChartPanel:
+		return m.renderShotChart(width, height)
+	case comparePanel:
+		return m.renderComparison(width, height)
+	}
 	if height < 3 {
 		return ""
 	}
 	// Period Selector
-	periods := []string{"1Q", "2Q", "3Q", "4Q"}
 	var selectorParts []string
-	for i, p := range periods {
-		pNum := i + 1
-		if pNum == m.selectedPeriod {
-			selectorParts = append(selectorParts, styles.UnderlineStyle.Render(p))
+	for _, p := range m.periods() {
+		label := PeriodLabel(p)
+		if p == m.selectedPeriod {
+			selectorParts = append(selectorParts, m.config.Theme.Underline.Render(label))
 		} else {
-			selectorParts = append(selectorParts, styles.FaintStyle.Render(p))
+			selectorParts = append(selectorParts, m.config.Theme.Faint.Render(label))
 		}
 	}
 	periodSelectorContent := strings.Join(selectorParts, " | ")
 	periodSelector := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(periodSelectorContent)
 
-	gameLogHeader := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render("gamelog")
+	title := "gamelog"
+	if m.logMode == allLogMode {
+		title = "gamelog (all)"
+	}
+	gameLogHeader := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(title)
 
 	filteredActions := m.getVisibleActions()
 
@@ -514,13 +712,23 @@ func (m Model) renderGameLog(width, height int) string {
 		if idx < len(filteredActions) {
 			action := filteredActions[idx]
 			desc := action.Description
-			clockWidth := 6
-			descMaxWidth := width - clockWidth
+			prefix := fmt.Sprintf("% -5s|", action.Clock)
+			if m.selectedPeriod == AllPeriods {
+				prefix = fmt.Sprintf("%-3s %s", PeriodLabel(action.Period), prefix)
+			}
+			if m.logMode == allLogMode {
+				score := "   -   "
+				if home, away, ok := ActionScore(action); ok {
+					score = fmt.Sprintf("%3d-%-3d", home, away)
+				}
+				prefix += fmt.Sprintf("%-3s|%s|", m.teamTricode(action.TeamID), score)
+			}
+			descMaxWidth := width - len(prefix)
 			if len(desc) > descMaxWidth && descMaxWidth > 3 {
 				desc = desc[:descMaxWidth-3] + "..."
 			}
 
-			line := fmt.Sprintf("% -5s|%s", action.Clock, desc)
+			line := prefix + desc
 
 			// Highlight matching rows
 			for _, matchIdx := range m.matchedIndices {
@@ -528,9 +736,9 @@ func (m Model) renderGameLog(width, height int) string {
 					// Check if it's the currently selected match
 					if idx == m.matchedIndices[m.currentMatchIndex] {
 						// Maybe distinct highlight for current match?
-						line = styles.HighlightStyle.Bold(true).Render(line)
+						line = m.config.Theme.Highlight.Bold(true).Render(line)
 					} else {
-						line = styles.HighlightStyle.Render(line)
+						line = m.config.Theme.Highlight.Render(line)
 					}
 					break
 				}
@@ -546,42 +754,19 @@ func (m Model) renderGameLog(width, height int) string {
 func (m Model) renderBoxScore(team types.Team, width, height int) string {
 	s := ""
 
-	// Define columns configuration
-	type col struct {
-		name  string
-		width int
-		align lipgloss.Position
-	}
-	cols := []col{
-		{"PLAYER", 15, lipgloss.Left},
-		{"MIN", 5, lipgloss.Left},
-		{"FGM", 3, lipgloss.Right},
-		{"FGA", 3, lipgloss.Right},
-		{"FG%", 5, lipgloss.Right},
-		{"3PM", 3, lipgloss.Right},
-		{"3PA", 3, lipgloss.Right},
-		{"3P%", 5, lipgloss.Right},
-		{"FTM", 3, lipgloss.Right},
-		{"FTA", 3, lipgloss.Right},
-		{"FT%", 5, lipgloss.Right},
-		{"OREB", 4, lipgloss.Right},
-		{"DREB", 4, lipgloss.Right},
-		{"REB", 3, lipgloss.Right},
-		{"AST", 3, lipgloss.Right},
-		{"STL", 3, lipgloss.Right},
-		{"BLK", 3, lipgloss.Right},
-		{"TO", 3, lipgloss.Right},
-		{"PF", 3, lipgloss.Right},
-		{"PTS", 3, lipgloss.Right},
-		{"+/-", 4, lipgloss.Right},
-	}
+	cols := m.visibleColumns()
 
+	// Row values are built in boxColumns order and picked by column name
+	valueIndex := make(map[string]int, len(boxColumns))
+	for i, c := range boxColumns {
+		valueIndex[c.name] = i
+	}
 	renderRow := func(vals []string) string {
 		parts := make([]string, 0, len(cols))
 		for i, c := range cols {
 			val := ""
-			if i < len(vals) {
-				val = vals[i]
+			if vi := valueIndex[m.columns[i].name]; vi < len(vals) {
+				val = vals[vi]
 			}
 			// Use lipgloss to align and pad. It handles ANSI width correctly.
 			cell := lipgloss.NewStyle().Width(c.width).Align(c.align).Render(val)
@@ -591,34 +776,34 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 	}
 
 	// Header
-	headerVals := make([]string, len(cols))
-	for i, c := range cols {
-		headerVals[i] = c.name
+	headerParts := make([]string, 0, len(cols))
+	for _, c := range cols {
+		headerParts = append(headerParts, lipgloss.NewStyle().Width(c.width).Align(c.align).Render(c.name))
 	}
-	fullHeader := renderRow(headerVals)
+	fullHeader := strings.Join(headerParts, " ")
 
 	// Apply horizontal scroll to header
-	header := styles.TableHeaderStyle.Render(m.scrollLine(fullHeader, width))
+	header := m.config.Theme.TableHeader.Render(m.scrollLine(fullHeader, width))
 	s += header + "\n"
 
 	if team.Players == nil {
 		return s + "No player data"
 	}
-	players := *team.Players
+	players := m.sortedPlayers(team)
 
 	// Calculate team highs
 	maxPts, maxReb, maxAst := -1, -1, -1
 	for _, p := range players {
 		if p.Statistics != nil {
-			stats := *p.Statistics
-			if stats.Pts != nil && *stats.Pts > maxPts {
-				maxPts = *stats.Pts
+			box := *p.Statistics
+			if box.Pts != nil && *box.Pts > maxPts {
+				maxPts = *box.Pts
 			}
-			if stats.Reb != nil && *stats.Reb > maxReb {
-				maxReb = *stats.Reb
+			if box.Reb != nil && *box.Reb > maxReb {
+				maxReb = *box.Reb
 			}
-			if stats.Ast != nil && *stats.Ast > maxAst {
-				maxAst = *stats.Ast
+			if box.Ast != nil && *box.Ast > maxAst {
+				maxAst = *box.Ast
 			}
 		}
 	}
@@ -631,7 +816,7 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 	}
 
 	// Height calculation: Header takes 2 lines (due to border?).
-	// Actually styles.TableHeaderStyle adds a bottom border, so it consumes vertical space?
+	// Actually the theme's TableHeader adds a bottom border, so it consumes vertical space?
 	// Render(str) -> content + border.
 	// If str is 1 line, result is 2 lines (content + border).
 	// So Header consumes 2 lines.
@@ -654,17 +839,18 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 
 			if p.Statistics == nil {
 				// Empty row
-				vals := make([]string, len(cols))
+				vals := make([]string, len(boxColumns))
 				vals[0] = name
 				vals[1] = "-"
 				s += m.scrollLine(renderRow(vals), width) + "\n"
 				continue
 			}
-			stats := *p.Statistics
+			advanced := stats.PlayerValues(p, team)
+			box := *p.Statistics
 
 			// Kawaii Mode Prefix
 			if m.config.KawaiiMode {
-				prefix := GetKawaiiPrefix(stats)
+				prefix := GetKawaiiPrefix(box)
 				if prefix != "" {
 					name = prefix + name
 				}
@@ -675,19 +861,19 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 				name = name[:15]
 			}
 
-			clockRaw := stats.MinutesClock()
+			clockRaw := box.MinutesClock()
 			min := "-"
 			if len(clockRaw) > 5 {
 				min = clockRaw[:5]
 			}
 
 			// Prepare individual stat strings
-			ptsVal := stats.Pts
-			rebVal := stats.Reb
-			astVal := stats.Ast
-			stlVal := stats.Stl
-			blkVal := stats.Blk
-			pmVal := stats.PlusMinus
+			ptsVal := box.Pts
+			rebVal := box.Reb
+			astVal := box.Ast
+			stlVal := box.Stl
+			blkVal := box.Blk
+			pmVal := box.PlusMinus
 
 			// Initialize base styles for each stat
 			ptsStyle := lipgloss.NewStyle()
@@ -697,7 +883,7 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 			blkStyle := lipgloss.NewStyle()
 			pmStyle := lipgloss.NewStyle()
 
-			if !m.config.NoDecoration { // Apply Team High bolding and Plus/Minus colors
+			if !m.config.Theme.Plain { // Apply Team High bolding and Plus/Minus colors
 				if ptsVal != nil && *ptsVal == maxPts && maxPts > 0 {
 					ptsStyle = ptsStyle.Bold(true)
 				}
@@ -710,9 +896,9 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 
 				if pmVal != nil {
 					if *pmVal > 0 {
-						pmStyle = pmStyle.Foreground(lipgloss.Color("2")) // Green
+						pmStyle = m.config.Theme.Positive
 					} else if *pmVal < 0 {
-						pmStyle = pmStyle.Foreground(lipgloss.Color("1")) // Red
+						pmStyle = m.config.Theme.Negative
 					}
 				}
 			}
@@ -743,30 +929,31 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 			blkStr := blkStyle.Render(utils.PtrToIntStr(blkVal))
 			pmStr := pmStyle.Render(utils.PtrToFloatStr2f(pmVal))
 
-			// Construct values matching cols order
+			// Construct values in boxColumns order
 			rowVals := []string{
 				name,
 				min,
-				utils.PtrToIntStr(stats.FgM),
-				utils.PtrToIntStr(stats.FgA),
-				utils.PtrToPctStr(stats.FgPct),
-				utils.PtrToIntStr(stats.Fg3M),
-				utils.PtrToIntStr(stats.Fg3A),
-				utils.PtrToPctStr(stats.Fg3Pct),
-				utils.PtrToIntStr(stats.FtM),
-				utils.PtrToIntStr(stats.FtA),
-				utils.PtrToPctStr(stats.FtPct),
-				utils.PtrToIntStr(stats.OReb),
-				utils.PtrToIntStr(stats.DReb),
+				utils.PtrToIntStr(box.FgM),
+				utils.PtrToIntStr(box.FgA),
+				utils.PtrToPctStr(box.FgPct),
+				utils.PtrToIntStr(box.Fg3M),
+				utils.PtrToIntStr(box.Fg3A),
+				utils.PtrToPctStr(box.Fg3Pct),
+				utils.PtrToIntStr(box.FtM),
+				utils.PtrToIntStr(box.FtA),
+				utils.PtrToPctStr(box.FtPct),
+				utils.PtrToIntStr(box.OReb),
+				utils.PtrToIntStr(box.DReb),
 				rebStr,
 				astStr,
 				stlStr,
 				blkStr,
-				utils.PtrToIntStr(stats.Tov),
-				utils.PtrToIntStr(stats.PF),
+				utils.PtrToIntStr(box.Tov),
+				utils.PtrToIntStr(box.PF),
 				ptsStr,
 				pmStr,
 			}
+			rowVals = append(rowVals, advanced...)
 
 			s += m.scrollLine(renderRow(rowVals), width) + "\n"
 		}
@@ -790,11 +977,12 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 		separator := strings.Repeat("─", width)
 		s += separator + "\n"
 
-		stats := *team.Statistics
+		advanced := stats.TeamValues(team)
+		box := *team.Statistics
 
 		min := "-"
-		if stats.Minutes != "" {
-			min = stats.MinutesClock()
+		if box.Minutes != "" {
+			min = box.MinutesClock()
 			if len(min) > 5 {
 				min = min[:5]
 			}
@@ -802,26 +990,27 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 
 		totalVals := []string{
 			"TOTAL", min,
-			utils.PtrToIntStr(stats.FgM),
-			utils.PtrToIntStr(stats.FgA),
-			utils.PtrToPctStr(stats.FgPct),
-			utils.PtrToIntStr(stats.Fg3M),
-			utils.PtrToIntStr(stats.Fg3A),
-			utils.PtrToPctStr(stats.Fg3Pct),
-			utils.PtrToIntStr(stats.FtM),
-			utils.PtrToIntStr(stats.FtA),
-			utils.PtrToPctStr(stats.FtPct),
-			utils.PtrToIntStr(stats.OReb),
-			utils.PtrToIntStr(stats.DReb),
-			utils.PtrToIntStr(stats.Reb),
-			utils.PtrToIntStr(stats.Ast),
-			utils.PtrToIntStr(stats.Stl),
-			utils.PtrToIntStr(stats.Blk),
-			utils.PtrToIntStr(stats.Tov),
-			utils.PtrToIntStr(stats.PF),
-			utils.PtrToIntStr(stats.Pts),
+			utils.PtrToIntStr(box.FgM),
+			utils.PtrToIntStr(box.FgA),
+			utils.PtrToPctStr(box.FgPct),
+			utils.PtrToIntStr(box.Fg3M),
+			utils.PtrToIntStr(box.Fg3A),
+			utils.PtrToPctStr(box.Fg3Pct),
+			utils.PtrToIntStr(box.FtM),
+			utils.PtrToIntStr(box.FtA),
+			utils.PtrToPctStr(box.FtPct),
+			utils.PtrToIntStr(box.OReb),
+			utils.PtrToIntStr(box.DReb),
+			utils.PtrToIntStr(box.Reb),
+			utils.PtrToIntStr(box.Ast),
+			utils.PtrToIntStr(box.Stl),
+			utils.PtrToIntStr(box.Blk),
+			utils.PtrToIntStr(box.Tov),
+			utils.PtrToIntStr(box.PF),
+			utils.PtrToIntStr(box.Pts),
 			"-",
 		}
+		totalVals = append(totalVals, advanced...)
 		s += m.scrollLine(renderRow(totalVals), width)
 	}
 
@@ -829,7 +1018,8 @@ func (m Model) renderBoxScore(team types.Team, width, height int) string {
 }
 
 func (m Model) scrollLine(line string, width int) string {
-	const fixedWidth = 16
+	// PLAYER and its separator stay put, wider with the sort indicator
+	fixedWidth := m.visibleColumns()[0].width + 1
 
 	// Visual cut for the fixed part (0 to fixedWidth)
 	fixed := ansi.Cut(line, 0, fixedWidth)
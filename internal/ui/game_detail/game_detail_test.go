@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	"nba-tui/internal/ui/styles"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
@@ -188,8 +190,12 @@ func TestUpdate_KeyEvents(t *testing.T) {
 		model, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlQ})
 		assert.Equal(t, 2, model.(Model).selectedPeriod)
 
+		// After 4Q comes ALL, then it wraps back to 1Q
 		m.selectedPeriod = 4
 		model, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlQ})
+		assert.Equal(t, AllPeriods, model.(Model).selectedPeriod)
+
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlQ})
 		assert.Equal(t, 1, model.(Model).selectedPeriod)
 	})
 
@@ -388,12 +394,37 @@ func TestView_BoldWinner(t *testing.T) {
 	}
 
 	view := m.View()
-	// \x1b[1m is bold, \x1b[0m is reset
-	if !strings.Contains(view, "\x1b[1mLAL\x1b[0m") {
+	// \x1b[1m is bold in Lakers gold, \x1b[0m is reset
+	if !strings.Contains(view, "\x1b[1;38;5;214mLAL\x1b[0m") {
 		t.Errorf("Winning team LAL should be bolded, got: %q", view)
 	}
 }
 
+func TestView_HeaderTeamColors(t *testing.T) {
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(termenv.ANSI256)
+	m := New(&mockNbaClient{}, "123", Config{})
+	m.width = 100
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamTricode: "LAL", Score: 110},
+			AwayTeam: types.Team{TeamTricode: "BKN", Score: 100},
+		},
+	}
+
+	header := m.renderHeaderStr()
+	// Lakers purple is too dark on a dark terminal, so gold
+	assert.Contains(t, header, "\x1b[1;38;2;253;185;39mLAL\x1b[0m")
+	assert.Contains(t, header, "\x1b[38;2;255;255;255mBKN\x1b[0m")
+
+	m.config.Theme = styles.Light()
+	header = m.renderHeaderStr()
+	assert.Contains(t, header, "\x1b[1;38;2;85;36;131mLAL\x1b[0m")
+	assert.Contains(t, header, "\x1b[38;2;0;0;0mBKN\x1b[0m")
+}
+
 func TestView_Footer(t *testing.T) {
 	client := &mockNbaClient{}
 	m := New(client, "123", Config{})
@@ -561,3 +592,134 @@ func TestUpdate_KeyEvents_Boundaries(t *testing.T) {
 		assert.Equal(t, 0, model.(Model).logOffset)
 	})
 }
+
+func TestUpdate_ExportBoxScore(t *testing.T) {
+	client := &mockNbaClient{}
+	m := New(client, "123", Config{ExportFormat: "md", ExportDir: "out"})
+	m.width = 100
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamTricode: "LAL"},
+			AwayTeam: types.Team{TeamTricode: "GSW"},
+		},
+	}
+
+	var gotName string
+	var gotData []byte
+	m.WriteFile = func(name string, data []byte) error {
+		gotName = name
+		gotData = data
+		return nil
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	assert.NotNil(t, cmd)
+	msg := cmd()
+
+	assert.Equal(t, "out/123_boxscore.md", gotName)
+	assert.Contains(t, string(gotData), "### LAL")
+
+	updated, _ := m.Update(msg)
+	assert.Contains(t, updated.View(), "Exported: out/123_boxscore.md")
+
+	t.Run("reports write failure", func(t *testing.T) {
+		failed, _ := m.Update(exportedMsg{path: "x.csv", err: fmt.Errorf("disk full")})
+		assert.Contains(t, failed.View(), "Export failed: disk full")
+	})
+
+	t.Run("ignored before data arrives", func(t *testing.T) {
+		empty := New(client, "123", Config{})
+		_, cmd := empty.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+		assert.Nil(t, cmd)
+	})
+}
+
+func TestUpdate_SearchOwnsKeys(t *testing.T) {
+	m := New(&mockNbaClient{}, "123", Config{})
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamTricode: "LAL"},
+			AwayTeam: types.Team{TeamTricode: "GSW"},
+		},
+	}
+	m.WriteFile = func(name string, data []byte) error {
+		t.Fatalf("export ran while typing a search: %s", name)
+		return nil
+	}
+
+	model, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	for _, r := range "steal" {
+		var cmd tea.Cmd
+		model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		if cmd != nil {
+			cmd()
+		}
+	}
+	got := model.(Model)
+	assert.True(t, got.searchMode)
+	assert.Equal(t, "steal", got.searchInput.Value())
+	assert.Equal(t, noSort, got.sortColumn, "s must not sort")
+	assert.Equal(t, m.panel, got.panel, "t must not open the comparison")
+	assert.Equal(t, m.logMode, got.logMode, "a must not switch the log mode")
+}
+
+func TestUpdate_ShotChartToggle(t *testing.T) {
+	players := []types.Player{{PersonID: 10}, {PersonID: 11}}
+	m := New(&mockNbaClient{}, "123", Config{})
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamTricode: "LAL", Players: &players},
+			AwayTeam: types.Team{TeamTricode: "GSW"},
+		},
+	}
+	press := func(m Model) Model {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+		return updated.(Model)
+	}
+
+	// From the box score the top row's chart opens and closes again
+	m.focus = boxScoreFocus
+	m = press(m)
+	assert.Equal(t, shotChartPanel, m.panel)
+	assert.Equal(t, 10, m.shotPlayerID)
+	m = press(m)
+	assert.Equal(t, gameLogPanel, m.panel)
+
+	// Another player's chart switches over
+	m = press(m)
+	m.boxOffset = 1
+	m = press(m)
+	assert.Equal(t, shotChartPanel, m.panel)
+	assert.Equal(t, 11, m.shotPlayerID)
+
+	// From the game log it goes back to the team chart, then closes
+	m.focus = gameLogFocus
+	m = press(m)
+	assert.Equal(t, shotChartPanel, m.panel)
+	assert.Equal(t, 0, m.shotPlayerID)
+	m = press(m)
+	assert.Equal(t, gameLogPanel, m.panel)
+}
+
+func TestUpdate_EnterSelectsPlayer(t *testing.T) {
+	players := []types.Player{{PersonID: 10}, {PersonID: 11}}
+	m := New(&mockNbaClient{}, "123", Config{})
+	m.boxScore = types.LiveBoxScoreResponse{Game: types.Game{
+		GameId:   "123",
+		HomeTeam: types.Team{TeamId: 1, Players: &players},
+	}}
+	m.boxOffset = 1
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, SelectPlayerMsg{PersonID: 11}, cmd())
+
+	// Only from the box score
+	m.focus = gameLogFocus
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+}
@@ -0,0 +1,141 @@
+package game_detail
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// LineScore holds the points each team scored per period.
+type LineScore struct {
+	Periods []int
+	Home    []int
+	Away    []int
+}
+
+// BuildLineScore uses the per-period scores of the box score when present
+// and otherwise reconstructs them from the running score of the play-by-play.
+func BuildLineScore(game types.Game, actions []types.Action) LineScore {
+	last := 4
+	if game.Period > last {
+		last = game.Period
+	}
+	for _, p := range game.HomeTeam.Periods {
+		if p.Period > last {
+			last = p.Period
+		}
+	}
+	for _, a := range actions {
+		if a.Period > last {
+			last = a.Period
+		}
+	}
+
+	ls := LineScore{
+		Periods: make([]int, last),
+		Home:    make([]int, last),
+		Away:    make([]int, last),
+	}
+	for i := range ls.Periods {
+		ls.Periods[i] = i + 1
+	}
+
+	if len(game.HomeTeam.Periods) > 0 || len(game.AwayTeam.Periods) > 0 {
+		for _, p := range game.HomeTeam.Periods {
+			if p.Period >= 1 && p.Period <= last {
+				ls.Home[p.Period-1] = p.Score
+			}
+		}
+		for _, p := range game.AwayTeam.Periods {
+			if p.Period >= 1 && p.Period <= last {
+				ls.Away[p.Period-1] = p.Score
+			}
+		}
+		return ls
+	}
+
+	// Running score at the end of each period
+	homeEnd := make([]int, last+1)
+	awayEnd := make([]int, last+1)
+	seen := make([]bool, last+1)
+	for _, a := range actions {
+		if a.Period < 1 {
+			continue
+		}
+		if home, away, ok := ActionScore(a); ok {
+			homeEnd[a.Period], awayEnd[a.Period] = home, away
+			seen[a.Period] = true
+		}
+	}
+	for p := 1; p <= last; p++ {
+		if !seen[p] {
+			homeEnd[p], awayEnd[p] = homeEnd[p-1], awayEnd[p-1]
+		}
+		ls.Home[p-1] = homeEnd[p] - homeEnd[p-1]
+		ls.Away[p-1] = awayEnd[p] - awayEnd[p-1]
+	}
+	return ls
+}
+
+// columns folds every overtime into a single OT column.
+func (ls LineScore) columns() (labels []string, home, away []int) {
+	otHome, otAway, hasOT := 0, 0, false
+	for i, p := range ls.Periods {
+		if p > 4 {
+			otHome += ls.Home[i]
+			otAway += ls.Away[i]
+			hasOT = true
+			continue
+		}
+		labels = append(labels, fmt.Sprintf("%dQ", p))
+		home = append(home, ls.Home[i])
+		away = append(away, ls.Away[i])
+	}
+	if hasOT {
+		labels = append(labels, "OT")
+		home = append(home, otHome)
+		away = append(away, otAway)
+	}
+	return labels, home, away
+}
+
+// Render draws the classic line score table.
+// Periods after currentPeriod have not been played and are shown as "-".
+func (ls LineScore) Render(homeTricode, awayTricode string, homeTotal, awayTotal, currentPeriod int) string {
+	labels, home, away := ls.columns()
+
+	played := func(i int) bool {
+		if labels[i] == "OT" {
+			return true
+		}
+		return i+1 <= currentPeriod
+	}
+
+	row := func(name string, vals []int, total int) string {
+		var b strings.Builder
+		fmt.Fprintf(&b, "%-3s", name)
+		for i, v := range vals {
+			if played(i) {
+				fmt.Fprintf(&b, " %3d", v)
+			} else {
+				fmt.Fprintf(&b, " %3s", "-")
+			}
+		}
+		fmt.Fprintf(&b, " %4d", total)
+		return b.String()
+	}
+
+	var header strings.Builder
+	header.WriteString("   ")
+	for _, l := range labels {
+		fmt.Fprintf(&header, " %3s", l)
+	}
+	fmt.Fprintf(&header, " %4s", "T")
+
+	return strings.Join([]string{
+		header.String(),
+		row(homeTricode, home, homeTotal),
+		row(awayTricode, away, awayTotal),
+	}, "\n")
+}
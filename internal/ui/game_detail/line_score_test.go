@@ -0,0 +1,99 @@
+package game_detail
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildLineScore(t *testing.T) {
+	t.Run("uses box score periods", func(t *testing.T) {
+		game := types.Game{
+			Period: 4,
+			HomeTeam: types.Team{Periods: []types.Period{
+				{Period: 1, Score: 30}, {Period: 2, Score: 25}, {Period: 3, Score: 28}, {Period: 4, Score: 27},
+			}},
+			AwayTeam: types.Team{Periods: []types.Period{
+				{Period: 1, Score: 20}, {Period: 2, Score: 31}, {Period: 3, Score: 22}, {Period: 4, Score: 29},
+			}},
+		}
+
+		ls := BuildLineScore(game, nil)
+		assert.Equal(t, []int{1, 2, 3, 4}, ls.Periods)
+		assert.Equal(t, []int{30, 25, 28, 27}, ls.Home)
+		assert.Equal(t, []int{20, 31, 22, 29}, ls.Away)
+	})
+
+	t.Run("reconstructs from play-by-play", func(t *testing.T) {
+		actions := []types.Action{
+			{Period: 1, ScoreHome: "2", ScoreAway: "0"},
+			{Period: 1, ScoreHome: "25", ScoreAway: "20"},
+			{Period: 1, Description: "Period End"},
+			{Period: 2, ScoreHome: "50", ScoreAway: "51"},
+			{Period: 4, ScoreHome: "100", ScoreAway: "100"},
+			{Period: 5, ScoreHome: "110", ScoreAway: "104"},
+		}
+
+		ls := BuildLineScore(types.Game{Period: 5}, actions)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, ls.Periods)
+		assert.Equal(t, []int{25, 25, 0, 50, 10}, ls.Home)
+		assert.Equal(t, []int{20, 31, 0, 49, 4}, ls.Away)
+	})
+
+	t.Run("regulation columns before data", func(t *testing.T) {
+		ls := BuildLineScore(types.Game{}, nil)
+		assert.Equal(t, []int{1, 2, 3, 4}, ls.Periods)
+		assert.Equal(t, []int{0, 0, 0, 0}, ls.Home)
+	})
+}
+
+func TestLineScore_Render(t *testing.T) {
+	t.Run("unplayed periods are dashes", func(t *testing.T) {
+		ls := LineScore{Periods: []int{1, 2, 3, 4}, Home: []int{30, 25, 0, 0}, Away: []int{20, 31, 0, 0}}
+		lines := strings.Split(ls.Render("LAL", "GSW", 55, 51, 2), "\n")
+
+		assert.Len(t, lines, 3)
+		assert.Equal(t, "     1Q  2Q  3Q  4Q    T", lines[0])
+		assert.Equal(t, "LAL  30  25   -   -   55", lines[1])
+		assert.Equal(t, "GSW  20  31   -   -   51", lines[2])
+	})
+
+	t.Run("overtimes fold into one column", func(t *testing.T) {
+		ls := LineScore{
+			Periods: []int{1, 2, 3, 4, 5, 6},
+			Home:    []int{25, 25, 25, 25, 10, 8},
+			Away:    []int{25, 25, 25, 25, 10, 5},
+		}
+		lines := strings.Split(ls.Render("LAL", "GSW", 118, 115, 6), "\n")
+
+		assert.Equal(t, "     1Q  2Q  3Q  4Q  OT    T", lines[0])
+		assert.Equal(t, "LAL  25  25  25  25  18  118", lines[1])
+		assert.Equal(t, "GSW  25  25  25  25  15  115", lines[2])
+	})
+}
+
+func TestView_HeaderLineScore(t *testing.T) {
+	m := New(&mockNbaClient{}, "123", Config{})
+	m.width = 120
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:     "123",
+			GameStatus: 3,
+			Period:     4,
+			HomeTeam:   types.Team{TeamTricode: "LAL", Score: 110, Periods: []types.Period{{Period: 1, Score: 30}}},
+			AwayTeam:   types.Team{TeamTricode: "GSW", Score: 100, Periods: []types.Period{{Period: 1, Score: 20}}},
+		},
+	}
+
+	view := stripANSI(m.View())
+	assert.Contains(t, view, "1Q  2Q  3Q  4Q    T")
+	assert.Contains(t, view, "LAL  30   0   0   0  110")
+
+	t.Run("hidden before tip-off", func(t *testing.T) {
+		m.boxScore.Game.GameStatus = 1
+		assert.NotContains(t, stripANSI(m.View()), "1Q  2Q  3Q  4Q    T")
+	})
+}
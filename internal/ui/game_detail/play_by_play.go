@@ -0,0 +1,93 @@
+package game_detail
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+type logMode int
+
+const (
+	teamLogMode logMode = iota // selected team only
+	allLogMode                 // both teams interleaved
+)
+
+// AllPeriods is the selectedPeriod value showing every period at once.
+const AllPeriods = 0
+
+// PeriodLabel returns the selector label of a period (1Q..4Q, OT1, OT2, ...).
+func PeriodLabel(period int) string {
+	switch {
+	case period == AllPeriods:
+		return "ALL"
+	case period > 4:
+		return fmt.Sprintf("OT%d", period-4)
+	default:
+		return fmt.Sprintf("%dQ", period)
+	}
+}
+
+// periods lists the selectable periods: regulation, any overtime seen in the
+// play-by-play or box score, followed by ALL.
+func (m Model) periods() []int {
+	last := 4
+	if m.boxScore.Game.Period > last {
+		last = m.boxScore.Game.Period
+	}
+	for _, action := range m.pbp.Game.Actions {
+		if action.Period > last {
+			last = action.Period
+		}
+	}
+
+	periods := make([]int, 0, last+1)
+	for p := 1; p <= last; p++ {
+		periods = append(periods, p)
+	}
+	return append(periods, AllPeriods)
+}
+
+// stepPeriod moves the period selector by delta, wrapping around.
+func (m Model) stepPeriod(delta int) Model {
+	periods := m.periods()
+	idx := 0
+	for i, p := range periods {
+		if p == m.selectedPeriod {
+			idx = i
+			break
+		}
+	}
+	idx = (idx + delta + len(periods)) % len(periods)
+	m.selectedPeriod = periods[idx]
+	m.logOffset = 0
+	m.matchedIndices = []int{}
+	m.currentMatchIndex = 0
+	return m
+}
+
+// ActionScore returns the running score after the action.
+// The feed reports it as strings on every action.
+func ActionScore(action types.Action) (home, away int, ok bool) {
+	home, errHome := strconv.Atoi(action.ScoreHome)
+	away, errAway := strconv.Atoi(action.ScoreAway)
+	if errHome != nil || errAway != nil {
+		return 0, 0, false
+	}
+	return home, away, true
+}
+
+// teamTricode resolves the tricode of an action's team from the box score.
+func (m Model) teamTricode(teamID int) string {
+	game := m.boxScore.Game
+	switch teamID {
+	case 0:
+		return ""
+	case game.HomeTeam.TeamId:
+		return game.HomeTeam.TeamTricode
+	case game.AwayTeam.TeamId:
+		return game.AwayTeam.TeamTricode
+	}
+	return ""
+}
@@ -0,0 +1,133 @@
+package game_detail
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionScore(t *testing.T) {
+	home, away, ok := ActionScore(types.Action{ScoreHome: "12", ScoreAway: "9"})
+	assert.True(t, ok)
+	assert.Equal(t, 12, home)
+	assert.Equal(t, 9, away)
+
+	_, _, ok = ActionScore(types.Action{})
+	assert.False(t, ok)
+}
+
+func TestGameLog_AllTeamsMode(t *testing.T) {
+	client := &mockNbaClient{}
+	m := New(client, "123", Config{})
+	m.width = 120
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamId: 1, TeamTricode: "LAL"},
+			AwayTeam: types.Team{TeamId: 2, TeamTricode: "GSW"},
+		},
+	}
+	m.pbp = types.LivePlayByPlayResponse{
+		Game: types.PlayByPlayGame{
+			Actions: []types.Action{
+				{Period: 1, TeamID: 1, Clock: "11:40", ScoreHome: "2", ScoreAway: "0", Description: "James layup"},
+				{Period: 1, TeamID: 2, Clock: "11:20", ScoreHome: "2", ScoreAway: "3", Description: "Curry 3pt"},
+				{Period: 1, TeamID: 1, Clock: "11:00", ScoreHome: "4", ScoreAway: "3", Description: "Davis dunk"},
+				{Period: 2, TeamID: 2, Clock: "12:00", ScoreHome: "30", ScoreAway: "28", Description: "Green jumper"},
+			},
+		},
+	}
+
+	// Team mode shows the selected team only
+	assert.Len(t, m.getVisibleActions(), 2)
+	assert.NotContains(t, m.View(), "Curry 3pt")
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	m = updated.(Model)
+	assert.True(t, m.IsShowingAllTeams())
+
+	actions := m.getVisibleActions()
+	assert.Len(t, actions, 3)
+	assert.Equal(t, []string{"James layup", "Curry 3pt", "Davis dunk"}, []string{
+		actions[0].Description, actions[1].Description, actions[2].Description,
+	})
+
+	view := stripANSI(m.View())
+	assert.Contains(t, view, "gamelog (all)")
+	assert.Contains(t, view, "11:40|LAL|  2-0  |James layup")
+	assert.Contains(t, view, "11:20|GSW|  2-3  |Curry 3pt")
+
+	// Switching the box score team keeps both teams in the log
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	assert.Len(t, updated.(Model).getVisibleActions(), 3)
+
+	// Search works over the interleaved log
+	m.matchedIndices = SearchActions(m.getVisibleActions(), "curry")
+	assert.Equal(t, []int{1}, m.matchedIndices)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	assert.False(t, updated.(Model).IsShowingAllTeams())
+	assert.False(t, strings.Contains(stripANSI(updated.View()), "gamelog (all)"))
+}
+
+func TestPeriodLabel(t *testing.T) {
+	assert.Equal(t, "1Q", PeriodLabel(1))
+	assert.Equal(t, "4Q", PeriodLabel(4))
+	assert.Equal(t, "OT1", PeriodLabel(5))
+	assert.Equal(t, "OT2", PeriodLabel(6))
+	assert.Equal(t, "ALL", PeriodLabel(AllPeriods))
+}
+
+func TestGameLog_OverTimePeriods(t *testing.T) {
+	client := &mockNbaClient{}
+	m := New(client, "123", Config{})
+	m.width = 120
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamId: 1, TeamTricode: "LAL"},
+			AwayTeam: types.Team{TeamId: 2, TeamTricode: "GSW"},
+		},
+	}
+	m.pbp = types.LivePlayByPlayResponse{
+		Game: types.PlayByPlayGame{
+			Actions: []types.Action{
+				{Period: 1, TeamID: 1, Clock: "11:40", Description: "Q1 basket"},
+				{Period: 5, TeamID: 1, Clock: "04:10", Description: "OT1 basket"},
+				{Period: 6, TeamID: 1, Clock: "00:02", Description: "OT2 winner"},
+			},
+		},
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, AllPeriods}, m.periods())
+	view := stripANSI(m.View())
+	assert.Contains(t, view, "1Q | 2Q | 3Q | 4Q | OT1 | OT2 | ALL")
+
+	t.Run("forward reaches overtime", func(t *testing.T) {
+		m.selectedPeriod = 4
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+		assert.Equal(t, 5, updated.(Model).GetSelectedPeriod())
+		assert.Contains(t, updated.View(), "OT1 basket")
+		assert.NotContains(t, updated.View(), "OT2 winner")
+	})
+
+	t.Run("backward wraps to ALL", func(t *testing.T) {
+		m.selectedPeriod = 1
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+		assert.Equal(t, AllPeriods, updated.(Model).GetSelectedPeriod())
+
+		view := stripANSI(updated.View())
+		assert.Contains(t, view, "1Q  11:40|Q1 basket")
+		assert.Contains(t, view, "OT2 00:02|OT2 winner")
+	})
+
+	t.Run("regulation only without overtime data", func(t *testing.T) {
+		empty := New(client, "123", Config{})
+		assert.Equal(t, []int{1, 2, 3, 4, AllPeriods}, empty.periods())
+	})
+}
@@ -0,0 +1,210 @@
+package game_detail
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"nba-tui/internal/ui/chart"
+	"nba-tui/internal/utils"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+const (
+	periodSeconds   = 12 * 60
+	overTimeSeconds = 5 * 60
+)
+
+func periodLength(period int) int {
+	if period > 4 {
+		return overTimeSeconds
+	}
+	return periodSeconds
+}
+
+// periodStart returns the game seconds elapsed before period starts.
+func periodStart(period int) int {
+	elapsed := 0
+	for p := 1; p < period; p++ {
+		elapsed += periodLength(p)
+	}
+	return elapsed
+}
+
+// GameElapsed converts a period and clock into seconds since tip-off.
+func GameElapsed(period int, clock string) (int, bool) {
+	remaining, ok := utils.ParseClock(clock)
+	if !ok || period < 1 {
+		return 0, false
+	}
+	return periodStart(period) + periodLength(period) - remaining, true
+}
+
+type FlowPoint struct {
+	Elapsed int
+	Margin  int // home minus away
+}
+
+// ScoreFlow is the score margin over game time.
+type ScoreFlow struct {
+	Points       []FlowPoint
+	Length       int // seconds through the last period seen
+	LeadChanges  int
+	Ties         int
+	LeadChangeAt []int // elapsed seconds of each lead change
+	TieAt        []int // elapsed seconds of each tie
+	HomeLargest  int
+	AwayLargest  int
+}
+
+func BuildScoreFlow(actions []types.Action) ScoreFlow {
+	flow := ScoreFlow{Length: periodStart(5)}
+	margin, leader := 0, 0
+
+	for _, a := range actions {
+		if end := periodStart(a.Period + 1); a.Period > 4 && end > flow.Length {
+			flow.Length = end
+		}
+		home, away, ok := ActionScore(a)
+		if !ok || home-away == margin {
+			continue
+		}
+		elapsed, ok := GameElapsed(a.Period, a.Clock)
+		if !ok {
+			continue
+		}
+
+		next := home - away
+		if next == 0 {
+			flow.Ties++
+			flow.TieAt = append(flow.TieAt, elapsed)
+		} else {
+			sign := 1
+			if next < 0 {
+				sign = -1
+			}
+			if leader != 0 && sign != leader {
+				flow.LeadChanges++
+				flow.LeadChangeAt = append(flow.LeadChangeAt, elapsed)
+			}
+			leader = sign
+		}
+		margin = next
+		flow.Points = append(flow.Points, FlowPoint{Elapsed: elapsed, Margin: margin})
+
+		if margin > flow.HomeLargest {
+			flow.HomeLargest = margin
+		}
+		if -margin > flow.AwayLargest {
+			flow.AwayLargest = -margin
+		}
+	}
+	return flow
+}
+
+// Render plots the margin with the home team above the zero line.
+func (f ScoreFlow) Render(width, height int, plain bool, homeTricode, awayTricode string) string {
+	const gutter = 4
+	leadMarker, tieMarker, marker := '×', '=', '●'
+	if plain {
+		leadMarker, marker = 'x', 'o'
+	}
+	summary := fmt.Sprintf("Lead chg %c: %d  Ties %c: %d  Max: %s+%d %s+%d",
+		leadMarker, f.LeadChanges, tieMarker, f.Ties, homeTricode, f.HomeLargest, awayTricode, f.AwayLargest)
+
+	chartW, chartH := width-gutter, height-2
+	if chartW < 8 || chartH < 3 {
+		return summary
+	}
+
+	canvas := chart.NewCanvas(chartW, chartH, plain)
+	dotW, dotH := canvas.DotSize()
+
+	maxAbs := 5
+	for _, p := range f.Points {
+		if abs := int(math.Abs(float64(p.Margin))); abs > maxAbs {
+			maxAbs = abs
+		}
+	}
+	toX := func(elapsed int) int {
+		return elapsed * (dotW - 1) / f.Length
+	}
+	toY := func(margin int) int {
+		return int(math.Round(float64(maxAbs-margin) / float64(2*maxAbs) * float64(dotH-1)))
+	}
+
+	zeroY := toY(0)
+	for x := 0; x < dotW; x++ {
+		if plain {
+			canvas.SetRune(x, zeroY, '-')
+		} else if x%3 == 0 {
+			canvas.Set(x, zeroY)
+		}
+	}
+
+	// Step plot: the margin holds until the next score
+	x0, y0 := 0, zeroY
+	for _, p := range f.Points {
+		x1, y1 := toX(p.Elapsed), toY(p.Margin)
+		chart.Line(canvas, x0, y0, x1, y0)
+		chart.Line(canvas, x1, y0, x1, y1)
+		x0, y0 = x1, y1
+	}
+
+	for _, p := range f.Points {
+		if (p.Margin > 0 && p.Margin == f.HomeLargest) || (p.Margin < 0 && -p.Margin == f.AwayLargest) {
+			canvas.SetRune(toX(p.Elapsed), toY(p.Margin), marker)
+		}
+	}
+
+	// Ties and lead changes sit on the zero line where they happened,
+	// drawn last so a largest lead next to them cannot hide them
+	for _, elapsed := range f.TieAt {
+		canvas.SetRune(toX(elapsed), zeroY, tieMarker)
+	}
+	for _, elapsed := range f.LeadChangeAt {
+		canvas.SetRune(toX(elapsed), zeroY, leadMarker)
+	}
+
+	rows := strings.Split(canvas.String(), "\n")
+	zeroRow := zeroY * chartH / dotH
+	for i := range rows {
+		label := ""
+		switch i {
+		case 0:
+			label = homeTricode
+		case zeroRow:
+			label = "0"
+		case len(rows) - 1:
+			label = awayTricode
+		}
+		rows[i] = fmt.Sprintf("%-*s", gutter, label) + rows[i]
+	}
+
+	// Period labels at their start positions
+	axis := []rune(strings.Repeat(" ", chartW))
+	for p := 1; periodStart(p) < f.Length; p++ {
+		cell := toX(periodStart(p)) * chartW / dotW
+		for i, r := range PeriodLabel(p) {
+			if cell+i < len(axis) {
+				axis[cell+i] = r
+			}
+		}
+	}
+	rows = append(rows, strings.Repeat(" ", gutter)+string(axis))
+
+	summary = lipgloss.NewStyle().MaxWidth(width).Render(summary)
+	return summary + "\n" + strings.Join(rows, "\n")
+}
+
+func (m Model) renderScoreFlow(width, height int) string {
+	title := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render("score flow")
+	if height < 2 {
+		return title
+	}
+	game := m.boxScore.Game
+	flow := BuildScoreFlow(m.pbp.Game.Actions)
+	return title + "\n" + flow.Render(width, height-1, m.config.Theme.Plain, game.HomeTeam.TeamTricode, game.AwayTeam.TeamTricode)
+}
@@ -0,0 +1,126 @@
+package game_detail
+
+import (
+	"strings"
+	"testing"
+
+	"nba-tui/internal/ui/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGameElapsed(t *testing.T) {
+	elapsed, ok := GameElapsed(1, "12:00")
+	assert.True(t, ok)
+	assert.Equal(t, 0, elapsed)
+
+	elapsed, _ = GameElapsed(2, "06:00")
+	assert.Equal(t, 720+360, elapsed)
+
+	elapsed, _ = GameElapsed(5, "PT04M00.00S")
+	assert.Equal(t, 2880+60, elapsed)
+
+	_, ok = GameElapsed(0, "12:00")
+	assert.False(t, ok)
+}
+
+func flowActions() []types.Action {
+	return []types.Action{
+		{Period: 1, Clock: "12:00", ScoreHome: "0", ScoreAway: "0"},
+		{Period: 1, Clock: "11:30", ScoreHome: "2", ScoreAway: "0"},
+		{Period: 1, Clock: "11:10", ScoreHome: "2", ScoreAway: "0"}, // miss, no change
+		{Period: 1, Clock: "11:00", ScoreHome: "2", ScoreAway: "3"},
+		{Period: 2, Clock: "08:00", ScoreHome: "20", ScoreAway: "20"},
+		{Period: 3, Clock: "05:00", ScoreHome: "60", ScoreAway: "48"},
+		{Period: 4, Clock: "00:10", ScoreHome: "98", ScoreAway: "96"},
+	}
+}
+
+func TestBuildScoreFlow(t *testing.T) {
+	flow := BuildScoreFlow(flowActions())
+
+	assert.Equal(t, []FlowPoint{
+		{Elapsed: 30, Margin: 2},
+		{Elapsed: 60, Margin: -1},
+		{Elapsed: 720 + 240, Margin: 0},
+		{Elapsed: 1440 + 420, Margin: 12},
+		{Elapsed: 2160 + 710, Margin: 2},
+	}, flow.Points)
+	assert.Equal(t, 2, flow.LeadChanges)
+	assert.Equal(t, []int{60, 1440 + 420}, flow.LeadChangeAt)
+	assert.Equal(t, 1, flow.Ties)
+	assert.Equal(t, []int{720 + 240}, flow.TieAt)
+	assert.Equal(t, 12, flow.HomeLargest)
+	assert.Equal(t, 1, flow.AwayLargest)
+	assert.Equal(t, 2880, flow.Length)
+
+	t.Run("overtime extends the timeline", func(t *testing.T) {
+		actions := append(flowActions(), types.Action{Period: 5, Clock: "02:00", ScoreHome: "100", ScoreAway: "96"})
+		assert.Equal(t, 2880+300, BuildScoreFlow(actions).Length)
+	})
+}
+
+func TestScoreFlow_Render(t *testing.T) {
+	flow := BuildScoreFlow(flowActions())
+
+	t.Run("ascii", func(t *testing.T) {
+		out := flow.Render(50, 12, true, "LAL", "GSW")
+		lines := strings.Split(out, "\n")
+
+		assert.Len(t, lines, 12)
+		assert.Equal(t, "Lead chg x: 2  Ties =: 1  Max: LAL+12 GSW+1", lines[0])
+		assert.True(t, strings.HasPrefix(lines[1], "LAL "))
+		assert.True(t, strings.HasPrefix(lines[10], "GSW "))
+		assert.Contains(t, out, "0   ")
+		assert.Contains(t, out, "*")
+		assert.Contains(t, out, "o")
+		// Lead changes and the tie are marked on the zero line
+		zero := lines[6]
+		assert.True(t, strings.HasPrefix(zero, "0   "))
+		assert.Equal(t, 2, strings.Count(zero[4:], "x"))
+		assert.Equal(t, 1, strings.Count(zero[4:], "="))
+		assert.Contains(t, lines[11], "1Q")
+		assert.Contains(t, lines[11], "4Q")
+		for _, l := range lines[1:] {
+			assert.Equal(t, 50, len([]rune(l)))
+		}
+	})
+
+	t.Run("braille", func(t *testing.T) {
+		out := flow.Render(50, 12, false, "LAL", "GSW")
+		assert.Contains(t, out, "●")
+		assert.Equal(t, 3, strings.Count(out, "×"), "one in the summary, two on the chart")
+		assert.Equal(t, 2, strings.Count(out, "="))
+		assert.Regexp(t, "[⠁-⣿]", out)
+	})
+
+	t.Run("too small shows summary only", func(t *testing.T) {
+		out := flow.Render(10, 3, true, "LAL", "GSW")
+		assert.NotContains(t, out, "\n")
+	})
+}
+
+func TestView_ScoreFlowToggle(t *testing.T) {
+	m := New(&mockNbaClient{}, "123", Config{Theme: styles.NoDecoration()})
+	m.width = 120
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamId: 1, TeamTricode: "LAL"},
+			AwayTeam: types.Team{TeamId: 2, TeamTricode: "GSW"},
+		},
+	}
+	m.pbp = types.LivePlayByPlayResponse{Game: types.PlayByPlayGame{Actions: flowActions()}}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	view := stripANSI(updated.View())
+	assert.Contains(t, view, "score flow")
+	assert.Contains(t, view, "Lead chg x: 2")
+	assert.NotContains(t, view, "gamelog")
+
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	assert.Contains(t, stripANSI(updated.View()), "gamelog")
+}
@@ -0,0 +1,199 @@
+package game_detail
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"nba-tui/internal/ui/chart"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+const (
+	courtWidth  = 50.0 // feet, sideline to sideline
+	courtLength = 94.0
+	halfCourt   = courtLength / 2
+	rimDepth    = 5.25 // rim center from the baseline
+	threeRadius = 23.75
+	threeCorner = 22.0
+	cornerDepth = 14.0 // where the corner three meets the arc
+	paintWidth  = 16.0
+	paintDepth  = 19.0
+)
+
+// Shot is a field goal attempt in half-court feet.
+// Across runs from the left sideline, Depth from the baseline.
+type Shot struct {
+	Across float64
+	Depth  float64
+	Made   bool
+	Three  bool
+}
+
+// ShotFromAction converts a field goal action of the feed into a Shot.
+// The feed reports x/y as percentages of the full court, so shots at the
+// far basket are mirrored onto the same half.
+func ShotFromAction(a types.Action) (Shot, bool) {
+	if a.IsFieldGoal != 1 || (a.ShotResult != "Made" && a.ShotResult != "Missed") {
+		return Shot{}, false
+	}
+	depth := a.X / 100 * courtLength
+	across := a.Y / 100 * courtWidth
+	if depth > halfCourt {
+		depth = courtLength - depth
+		across = courtWidth - across
+	}
+	return Shot{
+		Across: across,
+		Depth:  depth,
+		Made:   a.ShotResult == "Made",
+		Three:  a.ActionType == "3pt",
+	}, true
+}
+
+// FilterShots returns the shots of a team, or of a single player when
+// personID is set, in the given period (AllPeriods for every period).
+func FilterShots(actions []types.Action, teamID, personID, period int) []Shot {
+	var shots []Shot
+	for _, a := range actions {
+		if period != AllPeriods && a.Period != period {
+			continue
+		}
+		if personID != 0 {
+			if a.PersonID != personID {
+				continue
+			}
+		} else if a.TeamID != teamID {
+			continue
+		}
+		if shot, ok := ShotFromAction(a); ok {
+			shots = append(shots, shot)
+		}
+	}
+	return shots
+}
+
+// ShotSummary returns the FG and 3P splits of shots.
+func ShotSummary(shots []Shot) string {
+	fgm, fg3m, fg3a := 0, 0, 0
+	for _, s := range shots {
+		if s.Made {
+			fgm++
+		}
+		if s.Three {
+			fg3a++
+			if s.Made {
+				fg3m++
+			}
+		}
+	}
+	pct := func(made, att int) float64 {
+		if att == 0 {
+			return 0
+		}
+		return float64(made) / float64(att) * 100
+	}
+	return fmt.Sprintf("FG %d/%d (%.1f%%)  3P %d/%d (%.1f%%)",
+		fgm, len(shots), pct(fgm, len(shots)), fg3m, fg3a, pct(fg3m, fg3a))
+}
+
+// RenderShotChart draws a half court with the baseline on top.
+// Made shots are drawn as o/● and misses as x/×.
+func RenderShotChart(shots []Shot, width, height int, plain bool) string {
+	if width < 10 || height < 5 {
+		return ""
+	}
+	canvas := chart.NewCanvas(width, height, plain)
+	dotW, dotH := canvas.DotSize()
+
+	toDot := func(across, depth float64) (int, int) {
+		x := int(math.Round(across / courtWidth * float64(dotW-1)))
+		y := int(math.Round(depth / halfCourt * float64(dotH-1)))
+		return x, y
+	}
+	line := func(a0, d0, a1, d1 float64) {
+		x0, y0 := toDot(a0, d0)
+		x1, y1 := toDot(a1, d1)
+		chart.Line(canvas, x0, y0, x1, y1)
+	}
+
+	// Court outline and paint
+	line(0, 0, courtWidth, 0)
+	line(0, 0, 0, halfCourt)
+	line(courtWidth, 0, courtWidth, halfCourt)
+	line(0, halfCourt, courtWidth, halfCourt)
+	paintLeft := (courtWidth - paintWidth) / 2
+	line(paintLeft, 0, paintLeft, paintDepth)
+	line(paintLeft+paintWidth, 0, paintLeft+paintWidth, paintDepth)
+	line(paintLeft, paintDepth, paintLeft+paintWidth, paintDepth)
+
+	// Three point line: corners then the arc around the rim
+	center := courtWidth / 2
+	line(center-threeCorner, 0, center-threeCorner, cornerDepth)
+	line(center+threeCorner, 0, center+threeCorner, cornerDepth)
+	start := math.Acos(threeCorner / threeRadius)
+	for t := start; t <= math.Pi-start; t += 0.02 {
+		x, y := toDot(center+threeRadius*math.Cos(t), rimDepth+threeRadius*math.Sin(t))
+		canvas.Set(x, y)
+	}
+
+	rimX, rimY := toDot(center, rimDepth)
+	canvas.SetRune(rimX, rimY, 'O')
+
+	made, missed := '●', '×'
+	if plain {
+		made, missed = 'o', 'x'
+	}
+	for _, pass := range []bool{false, true} {
+		for _, s := range shots {
+			if s.Made != pass {
+				continue
+			}
+			x, y := toDot(s.Across, s.Depth)
+			if s.Made {
+				canvas.SetRune(x, y, made)
+			} else {
+				canvas.SetRune(x, y, missed)
+			}
+		}
+	}
+	return canvas.String()
+}
+
+// shotChartPlayer returns the player whose shots are shown, if any.
+func (m Model) shotChartPlayer() (types.Player, bool) {
+	if m.shotPlayerID == 0 {
+		return types.Player{}, false
+	}
+	for _, team := range []types.Team{m.boxScore.Game.HomeTeam, m.boxScore.Game.AwayTeam} {
+		if team.Players == nil {
+			continue
+		}
+		for _, p := range *team.Players {
+			if p.PersonID == m.shotPlayerID {
+				return p, true
+			}
+		}
+	}
+	return types.Player{}, false
+}
+
+func (m Model) renderShotChart(width, height int) string {
+	team := m.getCurrentTeam()
+	subject := team.TeamTricode
+	if p, ok := m.shotChartPlayer(); ok {
+		subject = strings.TrimSpace(p.FirstName + " " + p.FamilyName)
+	}
+
+	title := fmt.Sprintf("shot chart: %s (%s)", subject, PeriodLabel(m.selectedPeriod))
+	titleView := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(title)
+	if height < 3 {
+		return titleView
+	}
+
+	shots := FilterShots(m.pbp.Game.Actions, team.TeamId, m.shotPlayerID, m.selectedPeriod)
+	summary := lipgloss.NewStyle().Width(width).MaxWidth(width).Align(lipgloss.Center).Render(ShotSummary(shots))
+	return titleView + "\n" + summary + "\n" + RenderShotChart(shots, width, height-2, m.config.Theme.Plain)
+}
@@ -0,0 +1,120 @@
+package game_detail
+
+import (
+	"testing"
+
+	"nba-tui/internal/ui/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func shotActions() []types.Action {
+	return []types.Action{
+		{Period: 1, TeamID: 1, PersonID: 10, ActionType: "2pt", IsFieldGoal: 1, ShotResult: "Made", X: 5, Y: 50},
+		{Period: 1, TeamID: 1, PersonID: 11, ActionType: "3pt", IsFieldGoal: 1, ShotResult: "Missed", X: 25, Y: 50},
+		{Period: 2, TeamID: 1, PersonID: 10, ActionType: "3pt", IsFieldGoal: 1, ShotResult: "Made", X: 95, Y: 10},
+		{Period: 1, TeamID: 2, PersonID: 20, ActionType: "2pt", IsFieldGoal: 1, ShotResult: "Made", X: 90, Y: 50},
+		{Period: 1, TeamID: 1, PersonID: 10, ActionType: "freethrow", ShotResult: "Made"},
+		{Period: 1, TeamID: 1, PersonID: 10, ActionType: "rebound"},
+	}
+}
+
+func TestShotFromAction(t *testing.T) {
+	shot, ok := ShotFromAction(types.Action{IsFieldGoal: 1, ShotResult: "Made", ActionType: "3pt", X: 10, Y: 20})
+	assert.True(t, ok)
+	assert.InDelta(t, 9.4, shot.Depth, 0.001)
+	assert.InDelta(t, 10, shot.Across, 0.001)
+	assert.True(t, shot.Made)
+	assert.True(t, shot.Three)
+
+	// Shots at the far basket are mirrored onto the same half
+	shot, ok = ShotFromAction(types.Action{IsFieldGoal: 1, ShotResult: "Missed", X: 90, Y: 20})
+	assert.True(t, ok)
+	assert.InDelta(t, 9.4, shot.Depth, 0.001)
+	assert.InDelta(t, 40, shot.Across, 0.001)
+	assert.False(t, shot.Made)
+
+	_, ok = ShotFromAction(types.Action{ActionType: "freethrow", ShotResult: "Made"})
+	assert.False(t, ok)
+}
+
+func TestFilterShots(t *testing.T) {
+	actions := shotActions()
+
+	assert.Len(t, FilterShots(actions, 1, 0, AllPeriods), 3)
+	assert.Len(t, FilterShots(actions, 1, 0, 1), 2)
+	assert.Len(t, FilterShots(actions, 2, 0, AllPeriods), 1)
+	assert.Len(t, FilterShots(actions, 1, 10, AllPeriods), 2)
+	assert.Len(t, FilterShots(actions, 1, 10, 2), 1)
+}
+
+func TestShotSummary(t *testing.T) {
+	shots := FilterShots(shotActions(), 1, 0, AllPeriods)
+	assert.Equal(t, "FG 2/3 (66.7%)  3P 1/2 (50.0%)", ShotSummary(shots))
+	assert.Equal(t, "FG 0/0 (0.0%)  3P 0/0 (0.0%)", ShotSummary(nil))
+}
+
+func TestRenderShotChart(t *testing.T) {
+	shots := FilterShots(shotActions(), 1, 0, 1)
+
+	t.Run("ascii", func(t *testing.T) {
+		out := RenderShotChart(shots, 40, 15, true)
+		assert.Contains(t, out, "o")
+		assert.Contains(t, out, "x")
+		assert.Contains(t, out, "O") // rim
+		assert.Contains(t, out, "*") // court lines
+	})
+
+	t.Run("braille", func(t *testing.T) {
+		out := RenderShotChart(shots, 40, 15, false)
+		assert.Contains(t, out, "●")
+		assert.Contains(t, out, "×")
+		assert.Regexp(t, "[⠁-⣿]", out)
+	})
+
+	t.Run("too small", func(t *testing.T) {
+		assert.Empty(t, RenderShotChart(shots, 5, 3, true))
+	})
+}
+
+func TestView_ShotChart(t *testing.T) {
+	players := []types.Player{
+		{PersonID: 10, FirstName: "LeBron", FamilyName: "James"},
+		{PersonID: 11, FirstName: "Austin", FamilyName: "Reaves"},
+	}
+	m := New(&mockNbaClient{}, "123", Config{Theme: styles.NoDecoration()})
+	m.width = 120
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{
+		Game: types.Game{
+			GameId:   "123",
+			HomeTeam: types.Team{TeamId: 1, TeamTricode: "LAL", Players: &players},
+			AwayTeam: types.Team{TeamId: 2, TeamTricode: "GSW"},
+		},
+	}
+	m.pbp = types.LivePlayByPlayResponse{Game: types.PlayByPlayGame{Actions: shotActions()}}
+
+	// Team chart from the game log
+	m.focus = gameLogFocus
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	view := stripANSI(updated.View())
+	assert.Contains(t, view, "shot chart: LAL (1Q)")
+	assert.Contains(t, view, "FG 1/2")
+
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	assert.Contains(t, stripANSI(updated.View()), "gamelog")
+
+	// From the box score, the top row's player is charted
+	m.focus = boxScoreFocus
+	m.boxOffset = 1
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	view = stripANSI(updated.View())
+	assert.Contains(t, view, "shot chart: Austin Reaves (1Q)")
+	assert.Contains(t, view, "FG 0/1")
+
+	// Switching teams goes back to the team chart
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	assert.Contains(t, stripANSI(updated.View()), "shot chart: GSW (1Q)")
+}
@@ -0,0 +1,244 @@
+package game_detail
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// CompareRow is one stat of the team comparison.
+// Leader is 1 when home leads, -1 when away leads and 0 on a tie.
+type CompareRow struct {
+	Label  string
+	Home   string
+	Away   string
+	Leader int
+}
+
+// compareStat describes a row from a team's totals.
+type compareStat struct {
+	label       string
+	value       func(s types.TeamBoxScoreStatistic) float64
+	format      func(s types.TeamBoxScoreStatistic) string
+	lowerIsBest bool
+}
+
+func intOf(v *int) float64 {
+	if v == nil {
+		return 0
+	}
+	return float64(*v)
+}
+
+func pctOf(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func countStat(label string, get func(s types.TeamBoxScoreStatistic) *int) compareStat {
+	return compareStat{
+		label:  label,
+		value:  func(s types.TeamBoxScoreStatistic) float64 { return intOf(get(s)) },
+		format: func(s types.TeamBoxScoreStatistic) string { return fmt.Sprintf("%.0f", intOf(get(s))) },
+	}
+}
+
+func pctStat(label string, get func(s types.TeamBoxScoreStatistic) *float64) compareStat {
+	return compareStat{
+		label:  label,
+		value:  func(s types.TeamBoxScoreStatistic) float64 { return pctOf(get(s)) },
+		format: func(s types.TeamBoxScoreStatistic) string { return fmt.Sprintf("%.1f", pctOf(get(s))*100) },
+	}
+}
+
+func madeStat(label string, made, att func(s types.TeamBoxScoreStatistic) *int) compareStat {
+	return compareStat{
+		label: label,
+		value: func(s types.TeamBoxScoreStatistic) float64 { return intOf(made(s)) },
+		format: func(s types.TeamBoxScoreStatistic) string {
+			return fmt.Sprintf("%.0f-%.0f", intOf(made(s)), intOf(att(s)))
+		},
+	}
+}
+
+// keyStats come first so they stay visible in short panes.
+var keyStats = []compareStat{
+	countStat("PTS", func(s types.TeamBoxScoreStatistic) *int { return s.Pts }),
+	pctStat("FG%", func(s types.TeamBoxScoreStatistic) *float64 { return s.FgPct }),
+	pctStat("3P%", func(s types.TeamBoxScoreStatistic) *float64 { return s.Fg3Pct }),
+	countStat("REB", func(s types.TeamBoxScoreStatistic) *int { return s.Reb }),
+	{
+		label:       "TO",
+		value:       func(s types.TeamBoxScoreStatistic) float64 { return intOf(s.Tov) },
+		format:      func(s types.TeamBoxScoreStatistic) string { return fmt.Sprintf("%.0f", intOf(s.Tov)) },
+		lowerIsBest: true,
+	},
+}
+
+// totalStats are the rest of the TOTAL row.
+var totalStats = []compareStat{
+	madeStat("FGM-A", func(s types.TeamBoxScoreStatistic) *int { return s.FgM }, func(s types.TeamBoxScoreStatistic) *int { return s.FgA }),
+	madeStat("3PM-A", func(s types.TeamBoxScoreStatistic) *int { return s.Fg3M }, func(s types.TeamBoxScoreStatistic) *int { return s.Fg3A }),
+	madeStat("FTM-A", func(s types.TeamBoxScoreStatistic) *int { return s.FtM }, func(s types.TeamBoxScoreStatistic) *int { return s.FtA }),
+	pctStat("FT%", func(s types.TeamBoxScoreStatistic) *float64 { return s.FtPct }),
+	countStat("OREB", func(s types.TeamBoxScoreStatistic) *int { return s.OReb }),
+	countStat("DREB", func(s types.TeamBoxScoreStatistic) *int { return s.DReb }),
+	countStat("AST", func(s types.TeamBoxScoreStatistic) *int { return s.Ast }),
+	countStat("STL", func(s types.TeamBoxScoreStatistic) *int { return s.Stl }),
+	countStat("BLK", func(s types.TeamBoxScoreStatistic) *int { return s.Blk }),
+	{
+		label:       "PF",
+		value:       func(s types.TeamBoxScoreStatistic) float64 { return intOf(s.PF) },
+		format:      func(s types.TeamBoxScoreStatistic) string { return fmt.Sprintf("%.0f", intOf(s.PF)) },
+		lowerIsBest: true,
+	},
+}
+
+func leader(home, away float64, lowerIsBest bool) int {
+	if lowerIsBest {
+		home, away = -home, -away
+	}
+	switch {
+	case home > away:
+		return 1
+	case away > home:
+		return -1
+	}
+	return 0
+}
+
+// BenchPoints sums the points of everyone but the starters, going by the
+// feed's starter flag rather than the order of the players.
+func BenchPoints(team types.Team) int {
+	if team.Players == nil {
+		return 0
+	}
+	points := 0
+	for _, p := range *team.Players {
+		if p.Starter != "1" && p.Statistics != nil {
+			points += int(intOf(p.Statistics.Pts))
+		}
+	}
+	return points
+}
+
+// PointsOffTurnovers returns the home and away points scored on trips that
+// started with an opponent turnover. A trip ends with a defensive rebound,
+// an opponent score or turnover, or a new period; free throws right after
+// a made field goal still count.
+func PointsOffTurnovers(game types.Game, actions []types.Action) (int, int) {
+	homeID, awayID := game.HomeTeam.TeamId, game.AwayTeam.TeamId
+	points := map[int]int{}
+	beneficiary := 0 // team scoring off the turnover
+	ftOnly := false
+	prevHome, prevAway := 0, 0
+
+	for _, a := range actions {
+		home, away, ok := ActionScore(a)
+		if !ok {
+			home, away = prevHome, prevAway
+		}
+		scored := map[int]int{homeID: home - prevHome, awayID: away - prevAway}
+		prevHome, prevAway = home, away
+
+		if beneficiary != 0 {
+			opponent := homeID
+			if beneficiary == homeID {
+				opponent = awayID
+			}
+			switch {
+			case scored[beneficiary] > 0:
+				points[beneficiary] += scored[beneficiary]
+				if a.ActionType != "freethrow" {
+					ftOnly = true
+				}
+			case ftOnly && a.ActionType != "freethrow" && a.ActionType != "foul":
+				beneficiary = 0
+			case scored[opponent] > 0, a.ActionType == "period",
+				a.ActionType == "rebound" && a.TeamID == opponent:
+				beneficiary = 0
+			}
+		}
+
+		if a.ActionType == "turnover" {
+			switch a.TeamID {
+			case homeID:
+				beneficiary = awayID
+			case awayID:
+				beneficiary = homeID
+			}
+			ftOnly = false
+		}
+	}
+	return points[homeID], points[awayID]
+}
+
+// BuildComparison puts both teams' totals and derived stats side by side.
+func BuildComparison(game types.Game, actions []types.Action) []CompareRow {
+	home, away := game.HomeTeam.Statistics, game.AwayTeam.Statistics
+	if home == nil || away == nil {
+		return nil
+	}
+
+	fromStats := func(stats []compareStat) []CompareRow {
+		rows := make([]CompareRow, 0, len(stats))
+		for _, st := range stats {
+			rows = append(rows, CompareRow{
+				Label:  st.label,
+				Home:   st.format(*home),
+				Away:   st.format(*away),
+				Leader: leader(st.value(*home), st.value(*away), st.lowerIsBest),
+			})
+		}
+		return rows
+	}
+	countRow := func(label string, h, a int) CompareRow {
+		return CompareRow{Label: label, Home: fmt.Sprint(h), Away: fmt.Sprint(a), Leader: leader(float64(h), float64(a), false)}
+	}
+
+	rows := fromStats(keyStats)
+	homeOffTO, awayOffTO := PointsOffTurnovers(game, actions)
+	rows = append(rows,
+		countRow("PTS off TO", homeOffTO, awayOffTO),
+		countRow("Bench PTS", BenchPoints(game.HomeTeam), BenchPoints(game.AwayTeam)),
+	)
+	return append(rows, fromStats(totalStats)...)
+}
+
+func (m Model) renderComparison(width, height int) string {
+	game := m.boxScore.Game
+	title := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render("team comparison")
+	rows := BuildComparison(game, m.pbp.Game.Actions)
+	if len(rows) == 0 {
+		return title + "\n" + m.config.Theme.Faint.Render("No team stats yet.")
+	}
+
+	const labelWidth, valueWidth = 11, 8
+	mark := func(value string, lead bool) string {
+		if !lead {
+			return fmt.Sprintf("%*s", valueWidth, value)
+		}
+		if m.config.Theme.Plain {
+			return fmt.Sprintf("%*s", valueWidth, "*"+value)
+		}
+		return m.config.Theme.Bold.Render(fmt.Sprintf("%*s", valueWidth, value))
+	}
+
+	header := m.config.Theme.TableHeader.Render(fmt.Sprintf("%-*s%*s%*s", labelWidth, "",
+		valueWidth, game.HomeTeam.TeamTricode, valueWidth, game.AwayTeam.TeamTricode))
+	lines := make([]string, 0, len(rows))
+	for _, r := range rows {
+		lines = append(lines, fmt.Sprintf("%-*s%s%s", labelWidth, r.Label, mark(r.Home, r.Leader > 0), mark(r.Away, r.Leader < 0)))
+	}
+	// Title and header (with its border) take three lines
+	if room := max(height-3, 0); len(lines) > room {
+		lines = lines[:room]
+	}
+
+	table := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(header + "\n" + strings.Join(lines, "\n"))
+	return title + "\n" + table
+}
@@ -0,0 +1,121 @@
+package game_detail
+
+import (
+	"testing"
+
+	"nba-tui/internal/ui/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func compareGame() types.Game {
+	// The first five are the starters
+	players := func(points ...int) *[]types.Player {
+		ps := make([]types.Player, 0, len(points))
+		for i, pts := range points {
+			starter := "0"
+			if i < 5 {
+				starter = "1"
+			}
+			ps = append(ps, types.Player{Starter: starter, Statistics: &types.PlayerBoxScoreStatistic{
+				CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{Pts: ptr(pts)},
+			}})
+		}
+		return &ps
+	}
+	return types.Game{
+		GameId: "123",
+		HomeTeam: types.Team{
+			TeamId: 1, TeamTricode: "LAL", Players: players(20, 15, 10, 8, 7, 12, 6),
+			Statistics: &types.TeamBoxScoreStatistic{CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
+				Pts: ptr(78), FgPct: ptr(0.5), Fg3Pct: ptr(0.3), Reb: ptr(40), Tov: ptr(10), PF: ptr(15),
+			}},
+		},
+		AwayTeam: types.Team{
+			TeamId: 2, TeamTricode: "GSW", Players: players(30, 10, 10, 10, 10, 4),
+			Statistics: &types.TeamBoxScoreStatistic{CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
+				Pts: ptr(74), FgPct: ptr(0.45), Fg3Pct: ptr(0.4), Reb: ptr(40), Tov: ptr(14), PF: ptr(12),
+			}},
+		},
+	}
+}
+
+func TestBenchPoints(t *testing.T) {
+	game := compareGame()
+	assert.Equal(t, 18, BenchPoints(game.HomeTeam))
+	assert.Equal(t, 4, BenchPoints(game.AwayTeam))
+	assert.Equal(t, 0, BenchPoints(types.Team{}))
+
+	// Starters are found by their flag wherever they are listed
+	players := *game.HomeTeam.Players
+	shuffled := []types.Player{players[6], players[0], players[5], players[1], players[2], players[3], players[4]}
+	assert.Equal(t, 18, BenchPoints(types.Team{Players: &shuffled}))
+}
+
+func TestPointsOffTurnovers(t *testing.T) {
+	game := compareGame()
+	actions := []types.Action{
+		// GSW turns it over, LAL scores an and-one
+		{TeamID: 2, ActionType: "turnover", ScoreHome: "0", ScoreAway: "0"},
+		{TeamID: 1, ActionType: "2pt", ScoreHome: "2", ScoreAway: "0"},
+		{TeamID: 2, ActionType: "foul", ScoreHome: "2", ScoreAway: "0"},
+		{TeamID: 1, ActionType: "freethrow", ScoreHome: "3", ScoreAway: "0"},
+		// Regular GSW trip
+		{TeamID: 2, ActionType: "3pt", ScoreHome: "3", ScoreAway: "3"},
+		// LAL turns it over, GSW misses and LAL rebounds: no points
+		{TeamID: 1, ActionType: "turnover", ScoreHome: "3", ScoreAway: "3"},
+		{TeamID: 2, ActionType: "2pt", ScoreHome: "3", ScoreAway: "3"},
+		{TeamID: 1, ActionType: "rebound", ScoreHome: "3", ScoreAway: "3"},
+		{TeamID: 1, ActionType: "2pt", ScoreHome: "5", ScoreAway: "3"},
+		// LAL turns it over, GSW gets an offensive rebound and scores
+		{TeamID: 1, ActionType: "turnover", ScoreHome: "5", ScoreAway: "3"},
+		{TeamID: 2, ActionType: "2pt", ScoreHome: "5", ScoreAway: "3"},
+		{TeamID: 2, ActionType: "rebound", ScoreHome: "5", ScoreAway: "3"},
+		{TeamID: 2, ActionType: "2pt", ScoreHome: "5", ScoreAway: "5"},
+		// The next trip does not count
+		{TeamID: 2, ActionType: "rebound", ScoreHome: "5", ScoreAway: "5"},
+		{TeamID: 2, ActionType: "2pt", ScoreHome: "5", ScoreAway: "7"},
+	}
+
+	home, away := PointsOffTurnovers(game, actions)
+	assert.Equal(t, 3, home)
+	assert.Equal(t, 2, away)
+}
+
+func TestBuildComparison(t *testing.T) {
+	rows := BuildComparison(compareGame(), nil)
+	byLabel := map[string]CompareRow{}
+	for _, r := range rows {
+		byLabel[r.Label] = r
+	}
+
+	assert.Equal(t, CompareRow{Label: "PTS", Home: "78", Away: "74", Leader: 1}, byLabel["PTS"])
+	assert.Equal(t, CompareRow{Label: "FG%", Home: "50.0", Away: "45.0", Leader: 1}, byLabel["FG%"])
+	assert.Equal(t, -1, byLabel["3P%"].Leader)
+	assert.Equal(t, 0, byLabel["REB"].Leader)
+	assert.Equal(t, 1, byLabel["TO"].Leader, "fewer turnovers lead")
+	assert.Equal(t, -1, byLabel["PF"].Leader, "fewer fouls lead")
+	assert.Equal(t, CompareRow{Label: "Bench PTS", Home: "18", Away: "4", Leader: 1}, byLabel["Bench PTS"])
+	assert.Contains(t, byLabel, "PTS off TO")
+
+	assert.Nil(t, BuildComparison(types.Game{}, nil))
+}
+
+func TestView_Comparison(t *testing.T) {
+	m := New(&mockNbaClient{}, "123", Config{Theme: styles.NoDecoration()})
+	m.width = 120
+	m.height = 40
+	m.boxScore = types.LiveBoxScoreResponse{Game: compareGame()}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	view := stripANSI(updated.View())
+	assert.Contains(t, view, "team comparison")
+	assert.Regexp(t, `PTS\s+\*78\s+74`, view)
+	assert.Regexp(t, `TO\s+\*10\s+14`, view)
+	assert.Contains(t, view, "Bench PTS")
+
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	assert.Contains(t, stripANSI(updated.View()), "gamelog")
+}
@@ -0,0 +1,315 @@
+package keys
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// KeyMap holds every key binding of the TUI. Views dispatch on it and the
+// footers and the help overlay are rendered from it.
+type KeyMap struct {
+	// Everywhere
+	Quit  key.Binding
+	Back  key.Binding
+	Help  key.Binding
+	Watch key.Binding
+	Up    key.Binding
+	Down  key.Binding
+	Left  key.Binding
+	Right key.Binding
+
+	// Scoreboard
+	Exit         key.Binding
+	Select       key.Binding
+	FavoriteHome key.Binding
+	FavoriteAway key.Binding
+	Filter       key.Binding
+	Sort         key.Binding
+	PrevDay      key.Binding
+	NextDay      key.Binding
+
+	// Game detail
+	Search      key.Binding
+	NextMatch   key.Binding
+	PrevMatch   key.Binding
+	SwitchTeam  key.Binding
+	FocusBox    key.Binding
+	FocusLog    key.Binding
+	NextPeriod  key.Binding
+	PrevPeriod  key.Binding
+	LogMode     key.Binding
+	ScoreFlow   key.Binding
+	ShotChart   key.Binding
+	Compare     key.Binding
+	Player      key.Binding
+	SortNext    key.Binding
+	SortPrev    key.Binding
+	SortReverse key.Binding
+	Columns     key.Binding
+	Export      key.Binding
+
+	// Help-only summaries of several bindings, kept in sync by Apply
+	Move   key.Binding
+	Period key.Binding
+}
+
+// Default returns the built-in bindings.
+func Default() KeyMap {
+	return KeyMap{
+		Quit:  key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+		Back:  key.NewBinding(key.WithKeys("esc", "backspace"), key.WithHelp("esc", "back")),
+		Help:  key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "help")),
+		Watch: key.NewBinding(key.WithKeys("ctrl+w"), key.WithHelp("ctrl+w", "watch (browser)")),
+		Up:    key.NewBinding(key.WithKeys("k", "up"), key.WithHelp("k/↑", "up")),
+		Down:  key.NewBinding(key.WithKeys("j", "down"), key.WithHelp("j/↓", "down")),
+		Left:  key.NewBinding(key.WithKeys("h", "left"), key.WithHelp("h/←", "left")),
+		Right: key.NewBinding(key.WithKeys("l", "right"), key.WithHelp("l/→", "right")),
+
+		Exit:         key.NewBinding(key.WithKeys("q", "esc"), key.WithHelp("q/esc", "quit")),
+		Select:       key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "detail")),
+		FavoriteHome: key.NewBinding(key.WithKeys("f"), key.WithHelp("f", "favorite home team")),
+		FavoriteAway: key.NewBinding(key.WithKeys("F"), key.WithHelp("F", "favorite away team")),
+		Filter:       key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "filter")),
+		Sort:         key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort")),
+		PrevDay:      key.NewBinding(key.WithKeys("["), key.WithHelp("[", "previous day")),
+		NextDay:      key.NewBinding(key.WithKeys("]"), key.WithHelp("]", "next day")),
+
+		Search:      key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search log")),
+		NextMatch:   key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "next match")),
+		PrevMatch:   key.NewBinding(key.WithKeys("N"), key.WithHelp("N", "previous match")),
+		SwitchTeam:  key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "switch team")),
+		FocusBox:    key.NewBinding(key.WithKeys("ctrl+b"), key.WithHelp("ctrl+b", "box")),
+		FocusLog:    key.NewBinding(key.WithKeys("ctrl+l"), key.WithHelp("ctrl+l", "log")),
+		NextPeriod:  key.NewBinding(key.WithKeys("ctrl+q", "]"), key.WithHelp("ctrl+q/]", "next period")),
+		PrevPeriod:  key.NewBinding(key.WithKeys("["), key.WithHelp("[", "previous period")),
+		LogMode:     key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "all/team log")),
+		ScoreFlow:   key.NewBinding(key.WithKeys("f"), key.WithHelp("f", "score flow")),
+		ShotChart:   key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "shot chart")),
+		Compare:     key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "compare")),
+		Player:      key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "player")),
+		SortNext:    key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort by next column")),
+		SortPrev:    key.NewBinding(key.WithKeys("S"), key.WithHelp("S", "sort by previous column")),
+		SortReverse: key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "reverse sort")),
+		Columns:     key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "columns")),
+		Export:      key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "export")),
+
+		Move:   key.NewBinding(key.WithHelp("hjkli←↓↑→ ", "move")),
+		Period: key.NewBinding(key.WithHelp("ctrl+q/[ ]", "period")),
+	}
+}
+
+// bindings returns the rebindable bindings by their config name.
+func (k *KeyMap) bindings() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"quit":  &k.Quit,
+		"back":  &k.Back,
+		"help":  &k.Help,
+		"watch": &k.Watch,
+		"up":    &k.Up,
+		"down":  &k.Down,
+		"left":  &k.Left,
+		"right": &k.Right,
+
+		"exit":          &k.Exit,
+		"select":        &k.Select,
+		"favorite_home": &k.FavoriteHome,
+		"favorite_away": &k.FavoriteAway,
+		"filter":        &k.Filter,
+		"sort":          &k.Sort,
+		"prev_day":      &k.PrevDay,
+		"next_day":      &k.NextDay,
+
+		"search":       &k.Search,
+		"next_match":   &k.NextMatch,
+		"prev_match":   &k.PrevMatch,
+		"switch_team":  &k.SwitchTeam,
+		"focus_box":    &k.FocusBox,
+		"focus_log":    &k.FocusLog,
+		"next_period":  &k.NextPeriod,
+		"prev_period":  &k.PrevPeriod,
+		"log_mode":     &k.LogMode,
+		"score_flow":   &k.ScoreFlow,
+		"shot_chart":   &k.ShotChart,
+		"compare":      &k.Compare,
+		"player":       &k.Player,
+		"sort_next":    &k.SortNext,
+		"sort_prev":    &k.SortPrev,
+		"sort_reverse": &k.SortReverse,
+		"columns":      &k.Columns,
+		"export":       &k.Export,
+	}
+}
+
+// Apply rebinds the actions in overrides, which maps config names to keys.
+// An empty key list unbinds the action. A key may only be bound to one
+// action of each view.
+func (k KeyMap) Apply(overrides map[string][]string) (KeyMap, error) {
+	bindings := k.bindings()
+	for name, keys := range overrides {
+		b, ok := bindings[name]
+		if !ok {
+			return k, fmt.Errorf("unknown key action %q", name)
+		}
+		for _, s := range keys {
+			if strings.TrimSpace(s) == "" {
+				return k, fmt.Errorf("empty key for action %q", name)
+			}
+		}
+		if len(keys) == 0 {
+			b.Unbind()
+			continue
+		}
+		b.SetKeys(keys...)
+		b.SetHelp(strings.Join(keys, "/"), b.Help().Desc)
+	}
+	if err := k.checkConflicts(overrides); err != nil {
+		return k, err
+	}
+
+	if overridden(overrides, "up", "down", "left", "right") {
+		k.Move.SetHelp(joinHelp(k.Left, k.Down, k.Up, k.Right), "move")
+	}
+	if overridden(overrides, "next_period", "prev_period") {
+		k.Period.SetHelp(joinHelp(k.NextPeriod, k.PrevPeriod), "period")
+	}
+	return k, nil
+}
+
+// checkConflicts reports a key bound to two actions of the same view,
+// blaming the overridden one when only one of them is.
+func (k *KeyMap) checkConflicts(overrides map[string][]string) error {
+	names := make(map[*key.Binding]string)
+	for name, b := range k.bindings() {
+		names[b] = name
+	}
+	views := []struct {
+		name   string
+		groups [][]*key.Binding
+	}{
+		{"scoreboard", k.scoreboardGroups()},
+		{"game detail", k.detailGroups()},
+		{"player detail", k.playerGroups()},
+	}
+	for _, view := range views {
+		owners := make(map[string]string)
+		for _, group := range view.groups {
+			for _, b := range group {
+				name := names[b]
+				for _, s := range b.Keys() {
+					owner, ok := owners[s]
+					if !ok || owner == name {
+						owners[s] = name
+						continue
+					}
+					if overridden(overrides, owner) && !overridden(overrides, name) {
+						owner, name = name, owner
+					}
+					return fmt.Errorf("key %q of action %q is already used by %q in the %s", s, name, owner, view.name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func overridden(overrides map[string][]string, names ...string) bool {
+	for _, name := range names {
+		if _, ok := overrides[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// joinHelp joins the help keys of the bindings with "/". Unbound
+// bindings have no help and are skipped.
+func joinHelp(bindings ...key.Binding) string {
+	var parts []string
+	for _, b := range bindings {
+		if b.Help().Key != "" {
+			parts = append(parts, b.Help().Key)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// Hint renders "<keys>: desc" for a footer, joining the keys of several
+// bindings. It is empty when none of them is bound.
+func Hint(desc string, bindings ...key.Binding) string {
+	keys := joinHelp(bindings...)
+	if keys == "" {
+		return ""
+	}
+	return fmt.Sprintf("<%s>: %s", keys, desc)
+}
+
+// Footer renders the bindings as "<keys>: desc, ..." using their own help.
+func Footer(bindings ...key.Binding) string {
+	hints := make([]string, 0, len(bindings))
+	for _, b := range bindings {
+		hints = append(hints, Hint(b.Help().Desc, b))
+	}
+	return Join(hints...)
+}
+
+// Join joins footer parts with ", ", skipping empty ones.
+func Join(parts ...string) string {
+	var nonEmpty []string
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, ", ")
+}
+
+// ScoreboardHelp groups the scoreboard bindings for the help overlay.
+func (k KeyMap) ScoreboardHelp() [][]key.Binding {
+	return deref(k.scoreboardGroups())
+}
+
+// DetailHelp groups the game detail bindings for the help overlay.
+func (k KeyMap) DetailHelp() [][]key.Binding {
+	return deref(k.detailGroups())
+}
+
+// PlayerHelp groups the player detail bindings for the help overlay.
+func (k KeyMap) PlayerHelp() [][]key.Binding {
+	return deref(k.playerGroups())
+}
+
+func (k *KeyMap) scoreboardGroups() [][]*key.Binding {
+	return [][]*key.Binding{
+		{&k.Up, &k.Down, &k.Left, &k.Right, &k.PrevDay, &k.NextDay},
+		{&k.Select, &k.Watch, &k.FavoriteHome, &k.FavoriteAway, &k.Filter, &k.Sort},
+		{&k.Help, &k.Exit, &k.Quit},
+	}
+}
+
+func (k *KeyMap) detailGroups() [][]*key.Binding {
+	return [][]*key.Binding{
+		{&k.Up, &k.Down, &k.Left, &k.Right, &k.FocusBox, &k.FocusLog, &k.NextPeriod, &k.PrevPeriod},
+		{&k.SwitchTeam, &k.Player, &k.SortNext, &k.SortPrev, &k.SortReverse, &k.Columns, &k.Export},
+		{&k.LogMode, &k.Search, &k.NextMatch, &k.PrevMatch, &k.ScoreFlow, &k.ShotChart, &k.Compare},
+		{&k.Watch, &k.Help, &k.Back, &k.Quit},
+	}
+}
+
+func (k *KeyMap) playerGroups() [][]*key.Binding {
+	return [][]*key.Binding{
+		{&k.Up, &k.Down},
+		{&k.Help, &k.Back, &k.Quit},
+	}
+}
+
+func deref(groups [][]*key.Binding) [][]key.Binding {
+	out := make([][]key.Binding, len(groups))
+	for i, group := range groups {
+		for _, b := range group {
+			out[i] = append(out[i], *b)
+		}
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApply(t *testing.T) {
+	k, err := Default().Apply(map[string][]string{
+		"switch_team": {"T"},
+		"next_period": {"."},
+		"watch":       {},
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, key.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")}, k.SwitchTeam))
+	assert.False(t, key.Matches(tea.KeyMsg{Type: tea.KeyCtrlS}, k.SwitchTeam))
+	assert.Equal(t, "T", k.SwitchTeam.Help().Key)
+	assert.Equal(t, "switch team", k.SwitchTeam.Help().Desc)
+
+	// Unbound actions never match and drop out of the footer
+	assert.False(t, key.Matches(tea.KeyMsg{Type: tea.KeyCtrlW}, k.Watch))
+	assert.Equal(t, "", Hint("watch", k.Watch))
+
+	// Summaries follow their bindings
+	assert.Equal(t, "<./[>: period", Hint(k.Period.Help().Desc, k.Period))
+	assert.Equal(t, "<hjkli←↓↑→ >: move", Hint(k.Move.Help().Desc, k.Move))
+
+	// The default map is left alone
+	assert.True(t, key.Matches(tea.KeyMsg{Type: tea.KeyCtrlS}, Default().SwitchTeam))
+}
+
+func TestApply_Errors(t *testing.T) {
+	_, err := Default().Apply(map[string][]string{"teleport": {"x"}})
+	assert.ErrorContains(t, err, `unknown key action "teleport"`)
+
+	_, err = Default().Apply(map[string][]string{"quit": {" "}})
+	assert.ErrorContains(t, err, "empty key")
+
+	_, err = Default().Apply(map[string][]string{"switch_team": {"s"}})
+	assert.ErrorContains(t, err, `key "s" of action "switch_team" is already used by "sort_next" in the game detail`)
+
+	_, err = Default().Apply(map[string][]string{"filter": {"q"}})
+	assert.ErrorContains(t, err, `key "q" of action "filter" is already used by "exit" in the scoreboard`)
+
+	// The same key may do different things in different views
+	_, err = Default().Apply(map[string][]string{"sort": {"e"}})
+	assert.NoError(t, err)
+
+	// Moving the other binding away resolves the conflict
+	_, err = Default().Apply(map[string][]string{"switch_team": {"s"}, "sort_next": {"x"}})
+	assert.NoError(t, err)
+}
+
+func TestDefault_NoConflicts(t *testing.T) {
+	k := Default()
+	assert.NoError(t, k.checkConflicts(nil))
+}
+
+func TestFooter(t *testing.T) {
+	k := Default()
+	assert.Equal(t, "<enter>: detail, <ctrl+w>: watch (browser)", Footer(k.Select, k.Watch))
+	assert.Equal(t, "<s/S/r>: sort", Hint("sort", k.SortNext, k.SortPrev, k.SortReverse))
+	assert.Equal(t, "<a>: b, <c>: d", Join("<a>: b", "", "<c>: d"))
+}
@@ -0,0 +1,240 @@
+package player_detail
+
+import (
+	"fmt"
+	"strings"
+
+	"nba-tui/internal/export"
+	"nba-tui/internal/ui/game_detail"
+	"nba-tui/internal/ui/keys"
+	"nba-tui/internal/ui/styles"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// Model shows a single player's game: stat line, shooting splits,
+// their play-by-play actions across all periods and their shot chart.
+// The data is owned by the game detail view and handed over with SetData.
+type Model struct {
+	personID  int
+	boxScore  types.LiveBoxScoreResponse
+	pbp       types.LivePlayByPlayResponse
+	logOffset int
+	width     int
+	height    int
+	config    game_detail.Config
+	Keys      keys.KeyMap
+}
+
+func New(personID int, config game_detail.Config) Model {
+	if config.Theme.Name == "" {
+		config.Theme = styles.Default()
+	}
+	return Model{
+		personID: personID,
+		config:   config,
+		Keys:     keys.Default(),
+	}
+}
+
+func (m *Model) SetData(boxScore types.LiveBoxScoreResponse, pbp types.LivePlayByPlayResponse) {
+	m.boxScore = boxScore
+	m.pbp = pbp
+}
+
+func (m Model) PersonID() int {
+	return m.personID
+}
+
+func (m Model) GetLogOffset() int {
+	return m.logOffset
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.Keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, m.Keys.Down):
+			if m.logOffset < len(m.actions())-1 {
+				m.logOffset++
+			}
+		case key.Matches(msg, m.Keys.Up):
+			if m.logOffset > 0 {
+				m.logOffset--
+			}
+		}
+	}
+	return m, nil
+}
+
+// player returns the player and their team from the box score.
+func (m Model) player() (types.Player, types.Team, bool) {
+	for _, team := range []types.Team{m.boxScore.Game.HomeTeam, m.boxScore.Game.AwayTeam} {
+		if team.Players == nil {
+			continue
+		}
+		for _, p := range *team.Players {
+			if p.PersonID == m.personID {
+				return p, team, true
+			}
+		}
+	}
+	return types.Player{}, types.Team{}, false
+}
+
+// actions returns the player's actions of every period in game order.
+func (m Model) actions() []types.Action {
+	var actions []types.Action
+	for _, a := range m.pbp.Game.Actions {
+		if a.PersonID == m.personID {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
+
+func (m Model) View() string {
+	player, team, ok := m.player()
+	if !ok {
+		return fmt.Sprintf("Player not found. Press <%s> to go back.", m.Keys.Back.Help().Key)
+	}
+	if m.width < 30 || m.height < 10 {
+		return "Terminal too small. Please enlarge."
+	}
+
+	opponent := m.boxScore.Game.AwayTeam
+	if team.TeamId == opponent.TeamId {
+		opponent = m.boxScore.Game.HomeTeam
+	}
+	name := strings.TrimSpace(player.FirstName + " " + player.FamilyName)
+	title := m.config.Theme.Underline.Render(fmt.Sprintf("%s (%s vs %s)", name, team.TeamTricode, opponent.TeamTricode))
+
+	var statsContent string
+	if player.Statistics != nil {
+		statsContent = RenderStatLine(player, m.width-2, m.config.Theme) + "\n" + ShootingSplits(player.Statistics.CommonBoxScoreStatistic)
+	} else {
+		statsContent = "No stats yet."
+	}
+	statsView := m.config.Theme.Border.Width(m.width).Render(statsContent)
+
+	footerView := keys.Join(
+		keys.Hint("scroll plays", m.Keys.Down, m.Keys.Up),
+		keys.Footer(m.Keys.Back, m.Keys.Quit, m.Keys.Help),
+	)
+
+	h_main := m.height - 1 - lipgloss.Height(statsView) - lipgloss.Height(footerView)
+	if h_main < 4 {
+		return lipgloss.JoinVertical(lipgloss.Left, title, statsView, footerView)
+	}
+
+	shots := game_detail.FilterShots(m.pbp.Game.Actions, team.TeamId, m.personID, game_detail.AllPeriods)
+	var mainView string
+	if m.width >= 100 {
+		w_plays := (m.width * 6) / 10
+		w_chart := m.width - w_plays
+		plays := m.config.Theme.ActiveBorder.Width(w_plays).Height(h_main).MaxHeight(h_main).
+			Render(m.renderPlays(w_plays-2, h_main-2))
+		chart := m.config.Theme.Border.Width(w_chart).Height(h_main).MaxHeight(h_main).
+			Render(m.renderShotChart(shots, w_chart-2, h_main-2))
+		mainView = lipgloss.JoinHorizontal(lipgloss.Top, plays, chart)
+	} else {
+		h_plays := h_main / 2
+		h_chart := h_main - h_plays
+		plays := m.config.Theme.ActiveBorder.Width(m.width).Height(h_plays).MaxHeight(h_plays).
+			Render(m.renderPlays(m.width-2, h_plays-2))
+		chart := m.config.Theme.Border.Width(m.width).Height(h_chart).MaxHeight(h_chart).
+			Render(m.renderShotChart(shots, m.width-2, h_chart-2))
+		mainView = lipgloss.JoinVertical(lipgloss.Left, plays, chart)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, statsView, mainView, footerView)
+}
+
+// RenderStatLine renders the player's box score row under its column names,
+// truncated to width.
+func RenderStatLine(player types.Player, width int, theme styles.Theme) string {
+	names := export.BoxScoreColumns[1:]
+	values := export.PlayerRow(player)[1:]
+
+	header := make([]string, len(names))
+	row := make([]string, len(names))
+	for i, name := range names {
+		w := max(len(name), len(values[i]))
+		header[i] = fmt.Sprintf("%*s", w, name)
+		row[i] = fmt.Sprintf("%*s", w, values[i])
+	}
+	style := lipgloss.NewStyle().MaxWidth(width)
+	return style.Render(theme.TableHeader.Render(strings.Join(header, " "))) + "\n" +
+		style.Render(strings.Join(row, " "))
+}
+
+// ShootingSplits summarizes made/attempted and percentage for FG, 2P, 3P and FT.
+func ShootingSplits(stats types.CommonBoxScoreStatistic) string {
+	value := func(v *int) int {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	split := func(label string, made, att int) string {
+		pct := 0.0
+		if att > 0 {
+			pct = float64(made) / float64(att) * 100
+		}
+		return fmt.Sprintf("%s %d/%d (%.1f%%)", label, made, att, pct)
+	}
+	fgm, fga := value(stats.FgM), value(stats.FgA)
+	fg3m, fg3a := value(stats.Fg3M), value(stats.Fg3A)
+	return strings.Join([]string{
+		split("FG", fgm, fga),
+		split("2P", fgm-fg3m, fga-fg3a),
+		split("3P", fg3m, fg3a),
+		split("FT", value(stats.FtM), value(stats.FtA)),
+	}, "  ")
+}
+
+func (m Model) renderPlays(width, height int) string {
+	header := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render("plays")
+	actions := m.actions()
+	if len(actions) == 0 {
+		return header + "\n" + m.config.Theme.Faint.Render("No plays yet.")
+	}
+
+	lines := []string{header}
+	for i := 0; i < height-1; i++ {
+		idx := m.logOffset + i
+		if idx >= len(actions) {
+			break
+		}
+		action := actions[idx]
+		prefix := fmt.Sprintf("%-3s % -5s|", game_detail.PeriodLabel(action.Period), action.Clock)
+		desc := action.Description
+		if maxWidth := width - len(prefix); len(desc) > maxWidth && maxWidth > 3 {
+			desc = desc[:maxWidth-3] + "..."
+		}
+		lines = append(lines, prefix+desc)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) renderShotChart(shots []game_detail.Shot, width, height int) string {
+	center := lipgloss.NewStyle().Width(width).MaxWidth(width).Align(lipgloss.Center)
+	if height < 3 {
+		return center.Render("shot chart")
+	}
+	return center.Render("shot chart") + "\n" +
+		center.Render(game_detail.ShotSummary(shots)) + "\n" +
+		game_detail.RenderShotChart(shots, width, height-2, m.config.Theme.Plain)
+}
@@ -0,0 +1,89 @@
+package player_detail
+
+import (
+	"regexp"
+	"testing"
+
+	"nba-tui/internal/ui/game_detail"
+	"nba-tui/internal/ui/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func stripANSI(str string) string {
+	return regexp.MustCompile(`\x1b\[[0-9;]*m`).ReplaceAllString(str, "")
+}
+
+func testData() (types.LiveBoxScoreResponse, types.LivePlayByPlayResponse) {
+	players := []types.Player{{
+		PersonID:   2544,
+		FirstName:  "LeBron",
+		FamilyName: "James",
+		Statistics: &types.PlayerBoxScoreStatistic{
+			CommonBoxScoreStatistic: types.CommonBoxScoreStatistic{
+				Pts: ptr(25), FgM: ptr(10), FgA: ptr(20), Fg3M: ptr(2), Fg3A: ptr(5), FtM: ptr(3), FtA: ptr(4),
+			},
+		},
+	}}
+	box := types.LiveBoxScoreResponse{Game: types.Game{
+		GameId:   "123",
+		HomeTeam: types.Team{TeamId: 1, TeamTricode: "LAL", Players: &players},
+		AwayTeam: types.Team{TeamId: 2, TeamTricode: "GSW"},
+	}}
+	pbp := types.LivePlayByPlayResponse{Game: types.PlayByPlayGame{Actions: []types.Action{
+		{Period: 1, Clock: "10:00", TeamID: 1, PersonID: 2544, Description: "James 2pt Shot Made",
+			ActionType: "2pt", IsFieldGoal: 1, ShotResult: "Made", X: 5, Y: 50},
+		{Period: 1, Clock: "09:00", TeamID: 2, PersonID: 201939, Description: "Curry 3pt Shot Missed"},
+		{Period: 3, Clock: "05:00", TeamID: 1, PersonID: 2544, Description: "James Defensive Rebound"},
+	}}}
+	return box, pbp
+}
+
+func TestShootingSplits(t *testing.T) {
+	box, _ := testData()
+	stats := (*box.Game.HomeTeam.Players)[0].Statistics.CommonBoxScoreStatistic
+	assert.Equal(t, "FG 10/20 (50.0%)  2P 8/15 (53.3%)  3P 2/5 (40.0%)  FT 3/4 (75.0%)", ShootingSplits(stats))
+	assert.Equal(t, "FG 0/0 (0.0%)  2P 0/0 (0.0%)  3P 0/0 (0.0%)  FT 0/0 (0.0%)", ShootingSplits(types.CommonBoxScoreStatistic{}))
+}
+
+func TestRenderStatLine(t *testing.T) {
+	box, _ := testData()
+	out := stripANSI(RenderStatLine((*box.Game.HomeTeam.Players)[0], 200, styles.Default()))
+	assert.Contains(t, out, "MIN FGM FGA")
+	assert.NotContains(t, out, "PLAYER")
+	assert.Regexp(t, `\s10\s+20\s`, out)
+}
+
+func TestView(t *testing.T) {
+	box, pbp := testData()
+	m := New(2544, game_detail.Config{Theme: styles.NoDecoration()})
+	m.SetData(box, pbp)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	view := stripANSI(updated.View())
+	assert.Contains(t, view, "LeBron James (LAL vs GSW)")
+	assert.Contains(t, view, "FG 10/20 (50.0%)")
+	assert.Contains(t, view, "1Q  10:00|James 2pt Shot Made")
+	assert.Contains(t, view, "3Q  05:00|James Defensive Rebound")
+	assert.NotContains(t, view, "Curry")
+	assert.Contains(t, view, "FG 1/1 (100.0%)")
+
+	// Scrolling stops at the last play
+	for i := 0; i < 5; i++ {
+		updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	assert.Equal(t, 1, updated.(Model).GetLogOffset())
+}
+
+func TestView_UnknownPlayer(t *testing.T) {
+	box, pbp := testData()
+	m := New(1, game_detail.Config{})
+	m.SetData(box, pbp)
+	assert.Contains(t, m.View(), "Player not found")
+}
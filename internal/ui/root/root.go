@@ -1,11 +1,18 @@
 package root
 
 import (
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/poteto0/go-nba-sdk/types"
+	"nba-tui/internal/notify"
 	"nba-tui/internal/ui/game_detail"
+	"nba-tui/internal/ui/keys"
+	"nba-tui/internal/ui/player_detail"
 	"nba-tui/internal/ui/scoreboard"
 )
 
@@ -14,10 +21,12 @@ type state int
 const (
 	scoreboardView state = iota
 	detailView
+	playerView
 )
 
 type Client interface {
 	GetScoreboard() ([]types.Game, error)
+	GetScoreboardByDate(date time.Time) ([]types.Game, error)
 	GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error)
 	GetPlayByPlay(gameID string) (types.LivePlayByPlayResponse, error)
 }
@@ -36,21 +45,76 @@ type Model struct {
 	client          Client
 	scoreboardModel scoreboard.Model
 	detailModel     game_detail.Model
+	playerModel     player_detail.Model
 	state           state
 	gameID          string
 	width           int
 	height          int
 	config          game_detail.Config
 	reloadInterval  time.Duration // New field for reload interval
+	keys            keys.KeyMap
+	showHelp        bool
+	watcher         *notify.Watcher // nil when notifications are off
+	notifyFailed    bool            // the last delivery failed and says so
 }
 
+type notifiedMsg struct{ err error }
+
 func NewModel(client Client, config game_detail.Config, reload int) Model {
+	scoreboardModel := scoreboard.NewModel(client)
+	if config.Theme.Name != "" {
+		scoreboardModel.Theme = config.Theme
+	}
 	return Model{
 		client:          client,
-		scoreboardModel: scoreboard.NewModel(client),
+		scoreboardModel: scoreboardModel,
 		state:           scoreboardView,
 		config:          config,
 		reloadInterval:  time.Duration(reload) * time.Second,
+		keys:            keys.Default(),
+	}
+}
+
+// SetKeyMap sets the key bindings of every view.
+func (m *Model) SetKeyMap(k keys.KeyMap) {
+	m.keys = k
+	m.scoreboardModel.Keys = k
+	m.detailModel.Keys = k
+	m.playerModel.Keys = k
+}
+
+// SetFavorites sets the favorite teams of the scoreboard and how to
+// persist them when toggled.
+func (m *Model) SetFavorites(tricodes []string, save func([]string) error) {
+	m.scoreboardModel.SetFavorites(tricodes)
+	m.scoreboardModel.SaveFavorites = save
+}
+
+// SetCloseMargin sets the largest margin in points of a close game, for
+// the scoreboard's close games filter.
+func (m *Model) SetCloseMargin(points int) {
+	m.scoreboardModel.CloseMargin = points
+}
+
+// SetWatcher turns on notifications, or off when w is nil. The watcher
+// sees every scoreboard, box score and play-by-play fetch.
+func (m *Model) SetWatcher(w *notify.Watcher) {
+	m.watcher = w
+}
+
+// detect feeds a fetch to the watcher and delivers what it found.
+func (m Model) detect(observe func(*notify.Detector) []notify.Event) tea.Cmd {
+	if m.watcher == nil {
+		return nil
+	}
+	m.watcher.Detector.Favorites = m.scoreboardModel.Favorites
+	events := observe(m.watcher.Detector)
+	if len(events) == 0 {
+		return nil
+	}
+	w := m.watcher
+	return func() tea.Msg {
+		return notifiedMsg{err: w.Deliver(events)}
 	}
 }
 
@@ -70,15 +134,65 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.state = detailView
 		m.gameID = msg.GameId
 		m.detailModel = game_detail.New(m.client, m.gameID, m.config)
+		m.detailModel.Keys = m.keys
 		// Initialize with current width/height
 		dm, _ := m.detailModel.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
 		m.detailModel = dm.(game_detail.Model)
 		return m, tea.Batch(m.detailModel.Init(), tickCmd(m.reloadInterval))
 
+	case game_detail.SelectPlayerMsg:
+		m.state = playerView
+		m.playerModel = player_detail.New(msg.PersonID, m.config)
+		m.playerModel.Keys = m.keys
+		m.playerModel.SetData(m.detailModel.BoxScore(), m.detailModel.PlayByPlay())
+		pm, _ := m.playerModel.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		m.playerModel = pm.(player_detail.Model)
+		return m, nil
+
+	case scoreboard.GotScoreboardMsg:
+		if msg.Date.IsZero() {
+			cmds = append(cmds, m.detect(func(d *notify.Detector) []notify.Event {
+				return d.Scoreboard(msg.Games)
+			}))
+		}
+		// The scoreboard is also refreshed behind the detail view for
+		// notifications, so it always gets the result
+		newModel, cmd := m.scoreboardModel.Update(msg)
+		m.scoreboardModel = newModel.(scoreboard.Model)
+		return m, tea.Batch(append(cmds, cmd)...)
+
+	case notifiedMsg:
+		if msg.err == nil && !m.notifyFailed {
+			// Leaves the views' own messages alone
+			return m, nil
+		}
+		m.notifyFailed = msg.err != nil
+		status := ""
+		if msg.err != nil {
+			// Every failed sink is on a line of its own
+			status = "Notifying failed: " + strings.ReplaceAll(msg.err.Error(), "\n", "; ")
+		}
+		m.scoreboardModel.SetStatus(status)
+		m.detailModel.SetStatus(status)
+		return m, nil
+
+	case game_detail.BoxScoreMsg:
+		cmds = append(cmds, m.detect(func(d *notify.Detector) []notify.Event {
+			return d.Scoreboard([]types.Game{msg.Game})
+		}))
+
+	case game_detail.PlayByPlayMsg:
+		// A late response may be for the game open before this one
+		cmds = append(cmds, m.detect(func(d *notify.Detector) []notify.Event {
+			return d.PlayByPlay(msg.Game.GameID, msg.Game.Actions)
+		}))
+
 	case TickMsg:
-		if m.state == scoreboardView {
+		if m.state == scoreboardView || m.watcher != nil {
 			cmds = append(cmds, m.scoreboardModel.FetchScoreboard())
-		} else if m.state == detailView {
+		}
+		if m.state != scoreboardView {
+			// The player view shows the detail view's data, so refresh that too
 			// Ensure detailModel has the latest width/height before refreshing
 			dm, _ := m.detailModel.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
 			m.detailModel = dm.(game_detail.Model)
@@ -88,12 +202,44 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 
 	case tea.KeyMsg:
-		if m.state == detailView && (msg.String() == "esc" || msg.String() == "backspace") {
+		if m.showHelp {
+			// Any of these closes the overlay, everything else is ignored
+			switch {
+			case key.Matches(msg, m.keys.Quit):
+				return m, tea.Quit
+			case key.Matches(msg, m.keys.Help, m.keys.Back, m.keys.Exit):
+				m.showHelp = false
+			}
+			return m, nil
+		}
+		if key.Matches(msg, m.keys.Help) && !m.searching() {
+			m.showHelp = true
+			return m, nil
+		}
+		// The detail view is left untouched while a player is open
+		if m.state == playerView && key.Matches(msg, m.keys.Back) {
+			m.state = detailView
+			return m, nil
+		}
+		if m.state == detailView && key.Matches(msg, m.keys.Back) && !m.searching() {
 			m.state = scoreboardView
 			return m, tickCmd(m.reloadInterval)
 		}
 	}
 
+	if m.state == playerView {
+		// Fetch results and sizes still go to the detail view, which owns the data
+		if _, isKey := msg.(tea.KeyMsg); !isKey {
+			newModel, detailCmd := m.detailModel.Update(msg)
+			m.detailModel = newModel.(game_detail.Model)
+			m.playerModel.SetData(m.detailModel.BoxScore(), m.detailModel.PlayByPlay())
+			cmds = append(cmds, detailCmd)
+		}
+		newModel, playerCmd := m.playerModel.Update(msg)
+		m.playerModel = newModel.(player_detail.Model)
+		return m, tea.Batch(append(cmds, playerCmd)...)
+	}
+
 	if m.state == scoreboardView {
 		var newModel tea.Model
 		newModel, cmd = m.scoreboardModel.Update(msg)
@@ -104,12 +250,40 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.detailModel = newModel.(game_detail.Model)
 	}
 
-	return m, cmd
+	return m, tea.Batch(append(cmds, cmd)...)
+}
+
+// searching reports whether the detail view's search input has the keyboard.
+func (m Model) searching() bool {
+	return m.state == detailView && m.detailModel.Searching()
 }
 
 func (m Model) View() string {
-	if m.state == scoreboardView {
+	if m.showHelp {
+		return m.renderHelp()
+	}
+	switch m.state {
+	case scoreboardView:
 		return m.scoreboardModel.View()
+	case playerView:
+		return m.playerModel.View()
 	}
 	return m.detailModel.View()
 }
+
+// renderHelp renders every binding of the current view.
+func (m Model) renderHelp() string {
+	groups := m.keys.ScoreboardHelp()
+	title := "Scoreboard keys"
+	switch m.state {
+	case detailView:
+		groups, title = m.keys.DetailHelp(), "Game detail keys"
+	case playerView:
+		groups, title = m.keys.PlayerHelp(), "Player keys"
+	}
+
+	h := help.New()
+	h.Width = m.width
+	footer := keys.Hint("close", m.keys.Help, m.keys.Back)
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", h.FullHelpView(groups), "", footer)
+}
@@ -1,21 +1,31 @@
 package root
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/poteto0/go-nba-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"nba-tui/internal/notify"
 	"nba-tui/internal/ui/game_detail"
+	"nba-tui/internal/ui/keys"
 	"nba-tui/internal/ui/scoreboard"
 )
 
-type mockClient struct{}
+type mockClient struct {
+	requestedDate time.Time
+}
 
 func (m *mockClient) GetScoreboard() ([]types.Game, error) {
 	return []types.Game{{GameId: "123"}}, nil
 }
+func (m *mockClient) GetScoreboardByDate(date time.Time) ([]types.Game, error) {
+	m.requestedDate = date
+	return []types.Game{{GameId: "456"}}, nil
+}
 func (m *mockClient) GetBoxScore(gameID string) (types.LiveBoxScoreResponse, error) {
 	return types.LiveBoxScoreResponse{Game: types.Game{GameId: gameID}}, nil
 }
@@ -190,3 +200,263 @@ func TestRootModel_ReloadInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestRootModel_TickHonorsSelectedDate(t *testing.T) {
+	client := &mockClient{}
+	m := NewModel(client, game_detail.Config{}, 30)
+
+	// Step back one day on the scoreboard
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	rootM := updatedModel.(Model)
+	selected := rootM.scoreboardModel.Date
+	assert.False(t, selected.IsZero())
+
+	// Reload tick should refetch the selected date rather than the live slate
+	_, cmd := rootM.Update(TickMsg(time.Now()))
+	assert.NotNil(t, cmd)
+	batch, ok := cmd().(tea.BatchMsg)
+	assert.True(t, ok)
+	msg := batch[0]()
+
+	gotMsg, ok := msg.(scoreboard.GotScoreboardMsg)
+	assert.True(t, ok)
+	assert.Equal(t, "456", gotMsg.Games[0].GameId)
+	assert.True(t, selected.Equal(client.requestedDate))
+}
+
+func TestRootModel_PlayerDrillDown(t *testing.T) {
+	client := &mockClient{}
+	m := NewModel(client, game_detail.Config{}, 30)
+	updatedModel, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updatedModel.(Model)
+	updatedModel, _ = m.Update(scoreboard.SelectGameMsg{GameId: "123"})
+	m = updatedModel.(Model)
+
+	players := []types.Player{{PersonID: 10, FirstName: "LeBron", FamilyName: "James"}}
+	updatedModel, _ = m.Update(game_detail.BoxScoreMsg(types.LiveBoxScoreResponse{Game: types.Game{
+		GameId:   "123",
+		HomeTeam: types.Team{TeamId: 1, TeamTricode: "LAL", Players: &players},
+	}}))
+	m = updatedModel.(Model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	m = updatedModel.(Model)
+
+	updatedModel, _ = m.Update(game_detail.SelectPlayerMsg{PersonID: 10})
+	m = updatedModel.(Model)
+	assert.Equal(t, playerView, m.state)
+	assert.Equal(t, 10, m.playerModel.PersonID())
+
+	// New data reaches the player view through the detail view
+	updatedModel, _ = m.Update(game_detail.PlayByPlayMsg(types.LivePlayByPlayResponse{Game: types.PlayByPlayGame{
+		Actions: []types.Action{{Period: 1, Clock: "10:00", TeamID: 1, PersonID: 10, Description: "James Dunk"}},
+	}}))
+	m = updatedModel.(Model)
+	assert.Contains(t, m.View(), "James Dunk")
+
+	// Keys go to the player view only
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlB})
+	m = updatedModel.(Model)
+
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updatedModel.(Model)
+	assert.Equal(t, detailView, m.state)
+	assert.Equal(t, 1, m.detailModel.GetFocus()) // still on the game log
+}
+
+func TestRootModel_HelpOverlay(t *testing.T) {
+	m := NewModel(&mockClient{}, game_detail.Config{}, 30)
+	m.width, m.height = 120, 40
+
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	rootM := updatedModel.(Model)
+	view := rootM.View()
+	assert.Contains(t, view, "Scoreboard keys")
+	assert.Contains(t, view, "favorite home team")
+
+	// Keys do not reach the view while the overlay is open
+	updatedModel, _ = rootM.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	rootM = updatedModel.(Model)
+	assert.Equal(t, scoreboardView, rootM.state)
+	assert.True(t, rootM.showHelp)
+
+	updatedModel, _ = rootM.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	rootM = updatedModel.(Model)
+	assert.False(t, rootM.showHelp)
+
+	// The detail view lists its own bindings
+	rootM.state = detailView
+	updatedModel, _ = rootM.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	assert.Contains(t, updatedModel.View(), "Game detail keys")
+	assert.Contains(t, updatedModel.View(), "shot chart")
+}
+
+func TestRootModel_RemappedKeys(t *testing.T) {
+	m := NewModel(&mockClient{}, game_detail.Config{}, 30)
+	keymap, err := keys.Default().Apply(map[string][]string{
+		"back":        {"ctrl+h"},
+		"switch_team": {"T"},
+	})
+	assert.NoError(t, err)
+	m.SetKeyMap(keymap)
+
+	updatedModel, _ := m.Update(scoreboard.SelectGameMsg{GameId: "123"})
+	rootM := updatedModel.(Model)
+	assert.Contains(t, rootM.detailModel.Keys.SwitchTeam.Keys(), "T")
+
+	// esc is no longer back
+	updatedModel, _ = rootM.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	rootM = updatedModel.(Model)
+	assert.Equal(t, detailView, rootM.state)
+
+	updatedModel, _ = rootM.Update(tea.KeyMsg{Type: tea.KeyCtrlH})
+	rootM = updatedModel.(Model)
+	assert.Equal(t, scoreboardView, rootM.state)
+}
+
+// deliver updates m with msg and runs the commands it batched, which
+// delivers notifications.
+func deliver(m *Model, msg tea.Msg) {
+	updatedModel, cmd := m.Update(msg)
+	*m = updatedModel.(Model)
+	if cmd == nil {
+		return
+	}
+	if batch, ok := cmd().(tea.BatchMsg); ok {
+		for _, c := range batch {
+			if c != nil {
+				c()
+			}
+		}
+	}
+}
+
+func TestRootModel_Notifications(t *testing.T) {
+	var out bytes.Buffer
+	m := NewModel(&mockClient{}, game_detail.Config{}, 0)
+	m.SetWatcher(notify.NewWatcher(notify.Only{
+		Kinds:    map[notify.Kind]bool{notify.Final: true},
+		Notifier: notify.Bell{W: &out},
+	}))
+	live := types.Game{
+		GameId:     "123",
+		GameStatus: 2,
+		Period:     4,
+		HomeTeam:   types.Team{TeamTricode: "LAL", Score: 100},
+		AwayTeam:   types.Team{TeamTricode: "BOS", Score: 98},
+	}
+	deliver(&m, scoreboard.GotScoreboardMsg{Games: []types.Game{live}})
+	deliver(&m, scoreboard.SelectGameMsg{GameId: "123"})
+
+	// The scoreboard keeps refreshing behind the detail view
+	_, cmd := m.Update(TickMsg(time.Now()))
+	batch := cmd().(tea.BatchMsg)
+	_, ok := batch[0]().(scoreboard.GotScoreboardMsg)
+	assert.True(t, ok)
+
+	// Past slates are not watched
+	final := live
+	final.GameStatus = 3
+	deliver(&m, scoreboard.GotScoreboardMsg{Games: []types.Game{final}, Date: time.Now()})
+	assert.Empty(t, out.String())
+
+	deliver(&m, game_detail.BoxScoreMsg(types.LiveBoxScoreResponse{Game: final}))
+	assert.Equal(t, "\a", out.String())
+	assert.Equal(t, detailView, m.state)
+	assert.Equal(t, final.GameStatus, m.detailModel.BoxScore().Game.GameStatus)
+}
+
+func TestRootModel_NotificationsLatePlayByPlay(t *testing.T) {
+	var out bytes.Buffer
+	m := NewModel(&mockClient{}, game_detail.Config{}, 0)
+	m.SetFavorites([]string{"GSW"}, nil)
+	m.SetWatcher(notify.NewWatcher(notify.Only{
+		Kinds:    map[notify.Kind]bool{notify.FavoriteScore: true},
+		Notifier: notify.Bell{W: &out},
+	}))
+	live := func(id, home, away string) types.Game {
+		return types.Game{
+			GameId:     id,
+			GameStatus: 2,
+			Period:     2,
+			HomeTeam:   types.Team{TeamTricode: home, Score: 50},
+			AwayTeam:   types.Team{TeamTricode: away, Score: 48},
+		}
+	}
+	pbp := func(id string, actions ...types.Action) game_detail.PlayByPlayMsg {
+		return game_detail.PlayByPlayMsg{Game: types.PlayByPlayGame{GameID: id, Actions: actions}}
+	}
+	action := func(n int, home, away string) types.Action {
+		return types.Action{ActionNumber: n, Period: 2, Clock: "PT05M00.00S", ScoreHome: home, ScoreAway: away}
+	}
+	deliver(&m, scoreboard.GotScoreboardMsg{Games: []types.Game{live("123", "LAL", "BOS"), live("456", "GSW", "NYK")}})
+	deliver(&m, scoreboard.SelectGameMsg{GameId: "123"})
+	deliver(&m, scoreboard.SelectGameMsg{GameId: "456"})
+
+	// The play-by-play of the game open before arrives after the switch
+	deliver(&m, pbp("123", action(1, "0", "0"), action(300, "50", "48")))
+	deliver(&m, pbp("456", action(1, "50", "48")))
+	deliver(&m, pbp("456", action(1, "50", "48"), action(2, "52", "48")))
+	assert.Equal(t, "\a", out.String())
+}
+
+type failingNotifier struct{ err error }
+
+func (n failingNotifier) Notify(notify.Event) error { return n.err }
+
+func TestRootModel_NotificationErrors(t *testing.T) {
+	notifier := &failingNotifier{err: errors.New("webhook: 500 Internal Server Error")}
+	m := NewModel(&mockClient{}, game_detail.Config{}, 0)
+	m.SetWatcher(notify.NewWatcher(notify.Only{
+		Kinds:    map[notify.Kind]bool{notify.Final: true},
+		Notifier: notifier,
+	}))
+	game := func(status int) types.Game {
+		return types.Game{
+			GameId:     "123",
+			GameStatus: status,
+			Period:     4,
+			HomeTeam:   types.Team{TeamTricode: "LAL", Score: 100},
+			AwayTeam:   types.Team{TeamTricode: "BOS", Score: 98},
+		}
+	}
+	// update feeds the notification results back to the model
+	update := func(msg tea.Msg) {
+		updatedModel, cmd := m.Update(msg)
+		m = updatedModel.(Model)
+		if cmd == nil {
+			return
+		}
+		results := []tea.Msg{cmd()}
+		if batch, ok := results[0].(tea.BatchMsg); ok {
+			results = nil
+			for _, c := range batch {
+				if c != nil {
+					results = append(results, c())
+				}
+			}
+		}
+		for _, result := range results {
+			if result, ok := result.(notifiedMsg); ok {
+				updatedModel, _ = m.Update(result)
+				m = updatedModel.(Model)
+			}
+		}
+	}
+
+	update(scoreboard.GotScoreboardMsg{Games: []types.Game{game(2)}})
+	update(scoreboard.GotScoreboardMsg{Games: []types.Game{game(3)}})
+	assert.Contains(t, m.View(), "Notifying failed: webhook: 500 Internal Server Error")
+
+	// The next delivery that goes through clears it
+	notifier.err = nil
+	update(notifiedMsg{})
+	assert.NotContains(t, m.View(), "Notifying failed")
+}
+
+func TestRootModel_SetCloseMargin(t *testing.T) {
+	m := NewModel(&mockClient{}, game_detail.Config{}, 30)
+	assert.Equal(t, scoreboard.DefaultCloseMargin, m.scoreboardModel.CloseMargin)
+
+	m.SetCloseMargin(3)
+	assert.Equal(t, 3, m.scoreboardModel.CloseMargin)
+}
@@ -0,0 +1,122 @@
+package scoreboard
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"nba-tui/internal/ui/keys"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+type favoritesSavedMsg struct{ err error }
+
+// SetFavorites replaces the favorite teams by tricode.
+func (m *Model) SetFavorites(tricodes []string) {
+	m.Favorites = make(map[string]bool, len(tricodes))
+	for _, t := range tricodes {
+		m.Favorites[strings.ToUpper(t)] = true
+	}
+}
+
+// FavoriteList returns the favorite tricodes in alphabetical order.
+func (m Model) FavoriteList() []string {
+	list := make([]string, 0, len(m.Favorites))
+	for t, ok := range m.Favorites {
+		if ok {
+			list = append(list, t)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (m Model) isFavorite(game types.Game) bool {
+	return m.Favorites[game.HomeTeam.TeamTricode] || m.Favorites[game.AwayTeam.TeamTricode]
+}
+
+// pinFavorites moves favorite games to the top, keeping feed order otherwise.
+func (m Model) pinFavorites(games []types.Game) []types.Game {
+	pinned := append([]types.Game(nil), games...)
+	sort.SliceStable(pinned, func(i, j int) bool {
+		return m.isFavorite(pinned[i]) && !m.isFavorite(pinned[j])
+	})
+	return pinned
+}
+
+// focusedGameID returns the id of the focused game, if any.
+func (m Model) focusedGameID() string {
+	if m.Focus < len(m.Games) {
+		return m.Games[m.Focus].GameId
+	}
+	return ""
+}
+
+// refocus moves Focus to the game with id after the grid changed,
+// or keeps the position when that game is gone.
+func (m *Model) refocus(id string) {
+	if id != "" {
+		for i, g := range m.Games {
+			if g.GameId == id {
+				m.Focus = i
+				return
+			}
+		}
+	}
+	if m.Focus >= len(m.Games) {
+		m.Focus = 0
+	}
+}
+
+// focusFirstFavorite moves Focus to the first favorite game, if any.
+func (m *Model) focusFirstFavorite() {
+	for i, g := range m.Games {
+		if m.isFavorite(g) {
+			m.Focus = i
+			return
+		}
+	}
+}
+
+// toggleFavorite flips the favorite of a team of the focused game and
+// saves the new list.
+func (m Model) toggleFavorite(home bool) (Model, tea.Cmd) {
+	if len(m.Games) == 0 {
+		return m, nil
+	}
+	game := m.Games[m.Focus]
+	tricode := game.AwayTeam.TeamTricode
+	if home {
+		tricode = game.HomeTeam.TeamTricode
+	}
+	if m.Favorites == nil {
+		m.Favorites = map[string]bool{}
+	}
+	if m.Favorites[tricode] {
+		delete(m.Favorites, tricode)
+	} else {
+		m.Favorites[tricode] = true
+	}
+
+	m.refresh()
+	m.refocus(game.GameId)
+
+	if m.SaveFavorites == nil {
+		return m, nil
+	}
+	save, list := m.SaveFavorites, m.FavoriteList()
+	return m, func() tea.Msg {
+		return favoritesSavedMsg{err: save(list)}
+	}
+}
+
+func (m Model) renderFavorites() string {
+	favorites := "-"
+	if list := m.FavoriteList(); len(list) > 0 {
+		favorites = strings.Join(list, ", ")
+	}
+	hint := keys.Hint("toggle home/away team", m.Keys.FavoriteHome, m.Keys.FavoriteAway)
+	return fmt.Sprintf("Favorites: %s   %s", favorites, hint)
+}
@@ -0,0 +1,149 @@
+package scoreboard
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/poteto0/go-nba-sdk/types"
+)
+
+// DefaultCloseMargin is the largest 4th quarter margin of a close game.
+const DefaultCloseMargin = 5
+
+type filterMode int
+
+const (
+	filterAll filterMode = iota
+	filterLive
+	filterFinal
+	filterNotStarted
+	filterFavorites
+	filterClose
+	filterModeCount
+)
+
+type sortMode int
+
+const (
+	sortStartTime sortMode = iota
+	sortCloseness
+	sortStatus
+	sortModeCount
+)
+
+func (m Model) filterName() string {
+	switch m.filter {
+	case filterLive:
+		return "live"
+	case filterFinal:
+		return "final"
+	case filterNotStarted:
+		return "not started"
+	case filterFavorites:
+		return "favorites"
+	case filterClose:
+		return fmt.Sprintf("close (<=%d in 4th)", m.CloseMargin)
+	}
+	return "all"
+}
+
+func (m Model) sortName() string {
+	switch m.sort {
+	case sortCloseness:
+		return "closeness"
+	case sortStatus:
+		return "status"
+	}
+	return "start time"
+}
+
+func margin(game types.Game) int {
+	d := game.HomeTeam.Score - game.AwayTeam.Score
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func isLive(game types.Game) bool {
+	return game.IsGameStart() && !game.IsFinished()
+}
+
+// isClose reports a live game in the 4th or overtime within margin points.
+func isClose(game types.Game, closeMargin int) bool {
+	return isLive(game) && game.Period >= 4 && margin(game) <= closeMargin
+}
+
+func (m Model) matches(game types.Game) bool {
+	switch m.filter {
+	case filterLive:
+		return isLive(game)
+	case filterFinal:
+		return game.IsFinished()
+	case filterNotStarted:
+		return !game.IsGameStart()
+	case filterFavorites:
+		return m.isFavorite(game)
+	case filterClose:
+		return isClose(game, m.CloseMargin)
+	}
+	return true
+}
+
+// statusRank orders live games first, then upcoming, then finished.
+func statusRank(game types.Game) int {
+	switch {
+	case isLive(game):
+		return 0
+	case !game.IsGameStart():
+		return 1
+	}
+	return 2
+}
+
+func (m Model) less(a, b types.Game) bool {
+	switch m.sort {
+	case sortCloseness:
+		// Games that have not started have no margin and go last
+		if a.IsGameStart() != b.IsGameStart() {
+			return a.IsGameStart()
+		}
+		return margin(a) < margin(b)
+	case sortStatus:
+		return statusRank(a) < statusRank(b)
+	}
+	return a.GameTimeUTC < b.GameTimeUTC
+}
+
+// visibleGames filters and sorts the feed, favorites pinned on top.
+func (m Model) visibleGames() []types.Game {
+	games := make([]types.Game, 0, len(m.feed))
+	for _, g := range m.feed {
+		if m.matches(g) {
+			games = append(games, g)
+		}
+	}
+	sort.SliceStable(games, func(i, j int) bool {
+		return m.less(games[i], games[j])
+	})
+	return m.pinFavorites(games)
+}
+
+// refresh rebuilds Games from the feed keeping the focused game.
+func (m *Model) refresh() {
+	focusedID := m.focusedGameID()
+	m.Games = m.visibleGames()
+	m.refocus(focusedID)
+}
+
+func (m Model) cycleFilter() Model {
+	m.filter = (m.filter + 1) % filterModeCount
+	m.refresh()
+	return m
+}
+
+func (m Model) cycleSort() Model {
+	m.sort = (m.sort + 1) % sortModeCount
+	m.refresh()
+	return m
+}
@@ -0,0 +1,113 @@
+package scoreboard
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/poteto0/go-nba-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func filterGames() []types.Game {
+	game := func(id string, status, period, home, away int, start string) types.Game {
+		return types.Game{
+			GameId:      id,
+			GameStatus:  status,
+			Period:      period,
+			GameTimeUTC: start,
+			HomeTeam:    types.Team{TeamTricode: strings.ToUpper("h" + id), Score: home},
+			AwayTeam:    types.Team{TeamTricode: strings.ToUpper("a" + id), Score: away},
+		}
+	}
+	return []types.Game{
+		game("final", 3, 4, 110, 100, "2025-12-25T17:00:00Z"),
+		game("blowout", 2, 4, 90, 70, "2025-12-25T19:30:00Z"),
+		game("upcoming", 1, 0, 0, 0, "2025-12-26T01:00:00Z"),
+		game("close", 2, 4, 88, 86, "2025-12-25T20:00:00Z"),
+		game("early", 2, 2, 40, 40, "2025-12-25T22:00:00Z"),
+	}
+}
+
+func gameIDs(games []types.Game) []string {
+	ids := make([]string, 0, len(games))
+	for _, g := range games {
+		ids = append(ids, g.GameId)
+	}
+	return ids
+}
+
+func TestScoreboardFilters(t *testing.T) {
+	m := NewModel(&mockClient{})
+	m.SetFavorites([]string{"Hupcoming"})
+	m, _ = updateModel(m, GotScoreboardMsg{Games: filterGames()})
+
+	want := []struct {
+		name string
+		ids  []string
+	}{
+		{"live", []string{"blowout", "close", "early"}},
+		{"final", []string{"final"}},
+		{"not started", []string{"upcoming"}},
+		{"favorites", []string{"upcoming"}},
+		{"close (<=5 in 4th)", []string{"close"}},
+		{"all", []string{"upcoming", "final", "blowout", "close", "early"}},
+	}
+	for _, w := range want {
+		m, _ = updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+		assert.Equal(t, w.ids, gameIDs(m.Games), w.name)
+		assert.Contains(t, m.View(), "Filter: "+w.name)
+	}
+}
+
+func TestScoreboardCloseMargin(t *testing.T) {
+	m := NewModel(&mockClient{})
+	m.CloseMargin = 20
+	m.filter = filterClose
+	m, _ = updateModel(m, GotScoreboardMsg{Games: filterGames()})
+	assert.Equal(t, []string{"blowout", "close"}, gameIDs(m.Games))
+}
+
+func TestScoreboardSorts(t *testing.T) {
+	m := NewModel(&mockClient{})
+	m, _ = updateModel(m, GotScoreboardMsg{Games: filterGames()})
+	assert.Equal(t, []string{"final", "blowout", "close", "early", "upcoming"}, gameIDs(m.Games), "start time")
+
+	m, _ = updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Equal(t, []string{"early", "close", "final", "blowout", "upcoming"}, gameIDs(m.Games), "closeness")
+	assert.Contains(t, m.View(), "Sort: closeness")
+
+	m, _ = updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Equal(t, []string{"blowout", "close", "early", "upcoming", "final"}, gameIDs(m.Games), "status")
+
+	m, _ = updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Contains(t, m.View(), "Sort: start time")
+}
+
+func TestScoreboardFilterKeepsFocus(t *testing.T) {
+	m := NewModel(&mockClient{})
+	m, _ = updateModel(m, GotScoreboardMsg{Games: filterGames()})
+	m.Focus = 2 // close
+
+	// Still visible under the live filter
+	m, _ = updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	assert.Equal(t, "close", m.Games[m.Focus].GameId)
+
+	// Reloads keep the focused game even when the order changes
+	games := filterGames()
+	games[3].HomeTeam.Score = 120
+	m.sort = sortCloseness
+	m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+	assert.Equal(t, "close", m.Games[m.Focus].GameId)
+
+	// The focus stays in range when the game drops out
+	m.Focus = 2
+	m, _ = updateModel(m, GotScoreboardMsg{Games: filterGames()[:2]})
+	assert.Equal(t, []string{"blowout"}, gameIDs(m.Games))
+	assert.Equal(t, 0, m.Focus)
+
+	// Nothing left to show
+	m.filter = filterNotStarted
+	m, _ = updateModel(m, GotScoreboardMsg{Games: filterGames()[:2]})
+	assert.Contains(t, m.View(), "No games match the filter.")
+}
@@ -2,11 +2,13 @@ package scoreboard
 
 import (
 	"fmt"
+	"nba-tui/internal/ui/keys"
 	"nba-tui/internal/ui/styles"
 	"nba-tui/internal/utils"
 	"os/exec"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/poteto0/go-nba-sdk/types"
@@ -14,6 +16,7 @@ import (
 
 type GotScoreboardMsg struct {
 	Games []types.Game
+	Date  time.Time // zero for today's live slate
 }
 
 type SelectGameMsg struct {
@@ -22,6 +25,7 @@ type SelectGameMsg struct {
 
 type ScoreboardProvider interface {
 	GetScoreboard() ([]types.Game, error)
+	GetScoreboardByDate(date time.Time) ([]types.Game, error)
 }
 
 type Model struct {
@@ -33,33 +37,94 @@ type Model struct {
 	Width       int
 	Height      int
 	Columns     int
+	Date        time.Time       // zero means today's live slate
+	Favorites   map[string]bool // by tricode
+	CloseMargin int             // points, for the close games filter
 	OpenBrowser func(string) error
+	Keys        keys.KeyMap
+	Theme       styles.Theme
+	// SaveFavorites persists the favorites after they are toggled
+	SaveFavorites func([]string) error
+	statusMsg     string
+	feed          []types.Game // last fetched games, Games is what is shown
+	filter        filterMode
+	sort          sortMode
+	now           func() time.Time
 }
 
 func NewModel(client ScoreboardProvider) Model {
 	return Model{
-		client:  client,
-		Columns: 1, // Default to 1 column
+		client:      client,
+		Columns:     1, // Default to 1 column
+		CloseMargin: DefaultCloseMargin,
 		OpenBrowser: func(url string) error {
 			return exec.Command("xdg-open", url).Start()
 		},
+		Keys:  keys.Default(),
+		Theme: styles.Default(),
+		now:   time.Now,
 	}
 }
 
+// today returns the local midnight of the current day.
+func (m Model) today() time.Time {
+	now := time.Now()
+	if m.now != nil {
+		now = m.now()
+	}
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
+
+// SelectedDate returns the date of the slate being shown.
+func (m Model) SelectedDate() time.Time {
+	if m.Date.IsZero() {
+		return m.today()
+	}
+	return m.Date
+}
+
+// shiftDate moves the selected date by days and resets the grid.
+// Landing on today switches back to the live feed.
+func (m Model) shiftDate(days int) (Model, tea.Cmd) {
+	date := m.SelectedDate().AddDate(0, 0, days)
+	if date.Equal(m.today()) {
+		date = time.Time{}
+	}
+	m.Date = date
+	m.Games = nil
+	m.feed = nil
+	m.Focus = 0
+	m.Err = nil
+	m.LastUpdated = time.Time{}
+	return m, m.FetchScoreboard()
+}
+
 func (m Model) Init() tea.Cmd {
 	return m.FetchScoreboard()
 }
 
 func (m Model) FetchScoreboard() tea.Cmd {
+	date := m.Date
 	return func() tea.Msg {
-		games, err := m.client.GetScoreboard()
+		var games []types.Game
+		var err error
+		if date.IsZero() {
+			games, err = m.client.GetScoreboard()
+		} else {
+			games, err = m.client.GetScoreboardByDate(date)
+		}
 		if err != nil {
 			return err
 		}
-		return GotScoreboardMsg{Games: games}
+		return GotScoreboardMsg{Games: games, Date: date}
 	}
 }
 
+// SetStatus shows msg above the keys, or nothing when it is empty.
+func (m *Model) SetStatus(msg string) {
+	m.statusMsg = msg
+}
+
 func (m *Model) calculateColumns() {
 	if m.Width == 0 {
 		m.Columns = 1
@@ -96,20 +161,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.Err = msg
 		return m, nil
 	case GotScoreboardMsg:
-		m.Games = msg.Games
+		// Drop responses for a date we already navigated away from
+		if !msg.Date.Equal(m.Date) {
+			return m, nil
+		}
+		firstLoad := m.LastUpdated.IsZero()
+		m.feed = msg.Games
+		m.refresh()
+		if firstLoad {
+			m.focusFirstFavorite()
+		}
 		m.LastUpdated = time.Now()
 		return m, nil
+	case favoritesSavedMsg:
+		m.statusMsg = ""
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Saving favorites failed: %v", msg.err)
+		}
+		return m, nil
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c", "q", "esc":
+		switch {
+		case key.Matches(msg, m.Keys.Quit, m.Keys.Exit):
 			return m, tea.Quit
-		case "enter":
+		case key.Matches(msg, m.Keys.Select):
 			if len(m.Games) > 0 {
 				return m, func() tea.Msg {
 					return SelectGameMsg{GameId: m.Games[m.Focus].GameId}
 				}
 			}
-		case "ctrl+w":
+		case key.Matches(msg, m.Keys.Watch):
 			if len(m.Games) > 0 {
 				game := m.Games[m.Focus]
 				url := fmt.Sprintf("https://www.nba.com/game/%s", game.GameId)
@@ -117,19 +197,31 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					_ = m.OpenBrowser(url)
 				}
 			}
-		case "h", "left":
+		case key.Matches(msg, m.Keys.FavoriteHome):
+			return m.toggleFavorite(true)
+		case key.Matches(msg, m.Keys.FavoriteAway):
+			return m.toggleFavorite(false)
+		case key.Matches(msg, m.Keys.Filter):
+			return m.cycleFilter(), nil
+		case key.Matches(msg, m.Keys.Sort):
+			return m.cycleSort(), nil
+		case key.Matches(msg, m.Keys.PrevDay):
+			return m.shiftDate(-1)
+		case key.Matches(msg, m.Keys.NextDay):
+			return m.shiftDate(1)
+		case key.Matches(msg, m.Keys.Left):
 			if m.Focus > 0 {
 				m.Focus--
 			}
-		case "l", "right":
+		case key.Matches(msg, m.Keys.Right):
 			if m.Focus < len(m.Games)-1 {
 				m.Focus++
 			}
-		case "k", "up":
+		case key.Matches(msg, m.Keys.Up):
 			if m.Focus >= m.Columns {
 				m.Focus -= m.Columns
 			}
-		case "j", "down":
+		case key.Matches(msg, m.Keys.Down):
 			if m.Focus+m.Columns < len(m.Games) {
 				m.Focus += m.Columns
 			}
@@ -143,12 +235,24 @@ func (m Model) View() string {
 		return fmt.Sprintf("Error: %v", m.Err)
 	}
 
-	helpText := "<hjkli←↓↑→ >: move, <enter>: detail, <ctrl+w>: watch (browser), <q/esc>: quit"
+	k := m.Keys
+	helpText := keys.Footer(k.Move, k.Select, k.Watch, k.Exit, k.Help)
+	modes := fmt.Sprintf("Filter: %s   Sort: %s   %s", m.filterName(), m.sortName(), keys.Footer(k.Filter, k.Sort))
+	helpText = fmt.Sprintf("%s\n%s\n%s\n%s", m.renderDate(), m.renderFavorites(), modes, helpText)
+	if m.statusMsg != "" {
+		helpText = m.statusMsg + "\n" + helpText
+	}
 	if !m.LastUpdated.IsZero() {
 		helpText = fmt.Sprintf("Last updated: %s\n%s", m.LastUpdated.Format(time.RFC1123), helpText)
 	}
 
 	if len(m.Games) == 0 {
+		if len(m.feed) > 0 {
+			return helpText + "\n\nNo games match the filter."
+		}
+		if !m.LastUpdated.IsZero() {
+			return helpText + "\n\nNo games scheduled."
+		}
 		return helpText + "\n\nLoading..."
 	}
 
@@ -159,25 +263,32 @@ func (m Model) View() string {
 
 	var boards []string
 	for i, game := range m.Games {
-		style := styles.InactiveBorderStyle
-		if i == m.Focus {
-			style = styles.ActiveBorderStyle
+		style := m.Theme.TeamBorder(m.Theme.InactiveBorder, game.HomeTeam.TeamTricode)
+		switch {
+		case i == m.Focus && m.isFavorite(game):
+			style = m.Theme.ActiveFavoriteBorder
+		case i == m.Focus:
+			style = m.Theme.ActiveBorder
+		case m.isFavorite(game):
+			style = m.Theme.FavoriteBorder
 		}
 
 		status := utils.RenderGameStatus(game)
 
-		homeName := game.HomeTeam.TeamTricode
-		awayName := game.AwayTeam.TeamTricode
+		homeStyle := m.Theme.Team(game.HomeTeam.TeamTricode)
+		awayStyle := m.Theme.Team(game.AwayTeam.TeamTricode)
 		homeScoreStr := utils.FormatScore(game.HomeTeam.Score)
 		awayScoreStr := utils.FormatScore(game.AwayTeam.Score)
 
 		if game.HomeTeam.Score > game.AwayTeam.Score {
-			homeName = styles.BoldStyle.Render(homeName)
-			homeScoreStr = styles.BoldStyle.Render(homeScoreStr)
+			homeStyle = homeStyle.Inherit(m.Theme.Bold)
+			homeScoreStr = m.Theme.Bold.Render(homeScoreStr)
 		} else if game.AwayTeam.Score > game.HomeTeam.Score {
-			awayName = styles.BoldStyle.Render(awayName)
-			awayScoreStr = styles.BoldStyle.Render(awayScoreStr)
+			awayStyle = awayStyle.Inherit(m.Theme.Bold)
+			awayScoreStr = m.Theme.Bold.Render(awayScoreStr)
 		}
+		homeName := homeStyle.Render(game.HomeTeam.TeamTricode)
+		awayName := awayStyle.Render(game.AwayTeam.TeamTricode)
 
 		content := fmt.Sprintf(
 			"%s\n %s | %s\n ---------\n %s | %s",
@@ -203,3 +314,11 @@ func (m Model) View() string {
 	scoreboardView := lipgloss.JoinVertical(lipgloss.Left, rows...)
 	return lipgloss.JoinVertical(lipgloss.Left, helpText, scoreboardView)
 }
+
+func (m Model) renderDate() string {
+	date := m.SelectedDate().Format("Mon, 02 Jan 2006")
+	if m.Date.IsZero() {
+		date += " (Today)"
+	}
+	return fmt.Sprintf("Date: %s   %s", date, keys.Hint("prev/next day", m.Keys.PrevDay, m.Keys.NextDay))
+}
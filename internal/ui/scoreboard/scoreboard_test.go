@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"nba-tui/internal/ui/styles"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
@@ -13,14 +15,21 @@ import (
 )
 
 type mockClient struct {
-	games []types.Game
-	err   error
+	games         []types.Game
+	pastGames     []types.Game
+	err           error
+	requestedDate time.Time
 }
 
 func (m *mockClient) GetScoreboard() ([]types.Game, error) {
 	return m.games, m.err
 }
 
+func (m *mockClient) GetScoreboardByDate(date time.Time) ([]types.Game, error) {
+	m.requestedDate = date
+	return m.pastGames, m.err
+}
+
 func TestScoreboardView(t *testing.T) {
 	lipgloss.SetColorProfile(termenv.ANSI256)
 
@@ -72,8 +81,8 @@ func TestScoreboardView(t *testing.T) {
 		m.Games = games
 
 		view := m.View()
-		// Bold style escape code is \x1b[1m
-		assert.Contains(t, view, "\x1b[1mPOR\x1b[0m")
+		// Bold style escape code is \x1b[1m, here with Portland red
+		assert.Contains(t, view, "\x1b[1;38;5;167mPOR\x1b[0m")
 		assert.Contains(t, view, "\x1b[1m103\x1b[0m")
 	})
 
@@ -220,7 +229,7 @@ func TestScoreboardView(t *testing.T) {
 		m := NewModel(&mockClient{games: games})
 		m.Games = games
 		view := m.View()
-		assert.Contains(t, view, "\x1b[1mDEN\x1b[0m")
+		assert.Contains(t, view, "\x1b[1;38;5;220mDEN\x1b[0m")
 		assert.Contains(t, view, "\x1b[1m103\x1b[0m")
 	})
 
@@ -296,7 +305,220 @@ func TestScoreboardView(t *testing.T) {
 	})
 }
 
+func TestScoreboardDateNavigation(t *testing.T) {
+	fixedNow := time.Date(2025, 12, 26, 20, 30, 0, 0, time.Local)
+	today := time.Date(2025, 12, 26, 0, 0, 0, 0, time.Local)
+
+	t.Run("shows today in header by default", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.now = func() time.Time { return fixedNow }
+
+		view := m.View()
+		assert.Contains(t, view, "Date: Fri, 26 Dec 2025 (Today)")
+		assert.True(t, m.SelectedDate().Equal(today))
+	})
+
+	t.Run("[ steps back a day and fetches that slate", func(t *testing.T) {
+		pastGames := []types.Game{{GameId: "past"}}
+		client := &mockClient{pastGames: pastGames}
+		m := NewModel(client)
+		m.now = func() time.Time { return fixedNow }
+		m.Games = []types.Game{{GameId: "a"}, {GameId: "b"}}
+		m.Focus = 1
+
+		m, cmd := updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+		assert.True(t, m.Date.Equal(today.AddDate(0, 0, -1)))
+		assert.Equal(t, 0, m.Focus)
+		assert.Nil(t, m.Games)
+		assert.Contains(t, m.View(), "Date: Thu, 25 Dec 2025")
+		assert.NotContains(t, m.View(), "(Today)")
+
+		msg := cmd()
+		assert.True(t, client.requestedDate.Equal(today.AddDate(0, 0, -1)))
+		m, _ = updateModel(m, msg)
+		assert.Equal(t, pastGames, m.Games)
+	})
+
+	t.Run("] back to today switches to live feed", func(t *testing.T) {
+		games := []types.Game{{GameId: "live"}}
+		m := NewModel(&mockClient{games: games})
+		m.now = func() time.Time { return fixedNow }
+		m.Date = today.AddDate(0, 0, -1)
+
+		m, cmd := updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+		assert.True(t, m.Date.IsZero())
+
+		m, _ = updateModel(m, cmd())
+		assert.Equal(t, games, m.Games)
+	})
+
+	t.Run("] can step into future slates", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.now = func() time.Time { return fixedNow }
+
+		m, _ = updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+		assert.True(t, m.Date.Equal(today.AddDate(0, 0, 1)))
+	})
+
+	t.Run("ignores responses for another date", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.now = func() time.Time { return fixedNow }
+		m.Date = today.AddDate(0, 0, -2)
+
+		m, _ = updateModel(m, GotScoreboardMsg{Games: []types.Game{{GameId: "stale"}}})
+		assert.Nil(t, m.Games)
+	})
+
+	t.Run("shows empty slate once loaded", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m, _ = updateModel(m, GotScoreboardMsg{})
+		assert.Contains(t, m.View(), "No games scheduled.")
+	})
+}
+
 func updateModel(m Model, msg tea.Msg) (Model, tea.Cmd) {
 	newM, cmd := m.Update(msg)
 	return newM.(Model), cmd
 }
+
+func TestScoreboardFavorites(t *testing.T) {
+	game := func(id, home, away string) types.Game {
+		return types.Game{GameId: id, HomeTeam: types.Team{TeamTricode: home}, AwayTeam: types.Team{TeamTricode: away}}
+	}
+	games := []types.Game{
+		game("1", "POR", "DEN"),
+		game("2", "LAL", "GSW"),
+		game("3", "BOS", "MIA"),
+		game("4", "NYK", "LAL"),
+	}
+	ids := func(m Model) []string {
+		var out []string
+		for _, g := range m.Games {
+			out = append(out, g.GameId)
+		}
+		return out
+	}
+
+	t.Run("pins favorites and focuses the first one on launch", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.SetFavorites([]string{"lal"})
+		m.Focus = 2
+
+		m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+		assert.Equal(t, []string{"2", "4", "1", "3"}, ids(m))
+		assert.Equal(t, 0, m.Focus)
+		assert.Contains(t, m.View(), "Favorites: LAL")
+	})
+
+	t.Run("launch focus moves from a non-favorite to the first favorite", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.SetFavorites([]string{"MIA"})
+		m.Focus = 1 // game 1 after loading, not a favorite
+
+		m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+		assert.Equal(t, "3", m.Games[m.Focus].GameId)
+	})
+
+	t.Run("launch focus stays put without favorites", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.Focus = 1
+
+		m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+		assert.Equal(t, 1, m.Focus)
+	})
+
+	t.Run("focus follows the game across reloads", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.SetFavorites([]string{"LAL"})
+		m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+		m.Focus = 3 // game 3
+
+		m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+		assert.Equal(t, "3", m.Games[m.Focus].GameId)
+	})
+
+	t.Run("f and F toggle the focused game's teams and save", func(t *testing.T) {
+		var saved []string
+		m := NewModel(&mockClient{})
+		m.SaveFavorites = func(list []string) error {
+			saved = list
+			return nil
+		}
+		m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+		m.Focus = 2 // BOS - MIA
+
+		m, cmd := updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+		assert.Equal(t, []string{"3", "1", "2", "4"}, ids(m))
+		assert.Equal(t, "3", m.Games[m.Focus].GameId, "focus stays on the toggled game")
+		m, _ = updateModel(m, cmd())
+		assert.Equal(t, []string{"MIA"}, saved)
+
+		m, cmd = updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+		_, _ = updateModel(m, cmd())
+		assert.Equal(t, []string{"BOS", "MIA"}, saved)
+
+		m, cmd = updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+		m, _ = updateModel(m, cmd())
+		assert.Equal(t, []string{"BOS"}, saved)
+		assert.Equal(t, []string{"BOS"}, m.FavoriteList())
+	})
+
+	t.Run("save errors are shown", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.SaveFavorites = func([]string) error { return fmt.Errorf("read-only") }
+		m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+
+		m, cmd := updateModel(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+		m, _ = updateModel(m, cmd())
+		assert.Contains(t, m.View(), "Saving favorites failed: read-only")
+	})
+
+	t.Run("favorites use the rounded border", func(t *testing.T) {
+		m := NewModel(&mockClient{})
+		m.SetFavorites([]string{"BOS"})
+		m, _ = updateModel(m, GotScoreboardMsg{Games: games})
+
+		view := m.View()
+		assert.Contains(t, view, "╭")
+		assert.Contains(t, view, "┌")
+	})
+}
+
+func TestScoreboardTeamColors(t *testing.T) {
+	defer lipgloss.SetColorProfile(termenv.ANSI256)
+	games := []types.Game{{
+		GameId:   "1",
+		HomeTeam: types.Team{TeamTricode: "POR", Score: 103},
+		AwayTeam: types.Team{TeamTricode: "DEN", Score: 100},
+	}, {
+		GameId:   "2",
+		HomeTeam: types.Team{TeamTricode: "BOS"},
+		AwayTeam: types.Team{TeamTricode: "NYK"},
+	}}
+	m := NewModel(&mockClient{games: games})
+	m.Games = games
+
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	view := m.View()
+	// Winner bold in Portland red, Denver navy is too dark so gold
+	assert.Contains(t, view, "\x1b[1;38;2;224;58;62mPOR\x1b[0m")
+	assert.Contains(t, view, "\x1b[38;2;254;197;36mDEN\x1b[0m")
+	// Unfocused card in the home team's green
+	assert.Contains(t, view, "\x1b[38;2;0;121;51m┌")
+
+	// Themes without team colors keep the plain tricodes
+	m.Theme = styles.Monochrome()
+	assert.NotContains(t, m.View(), "\x1b[38;2;224;58;62m")
+
+	// 256 and 16 color terminals get the closest color
+	m.Theme = styles.Default()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	assert.Contains(t, m.View(), "\x1b[1;38;5;167mPOR\x1b[0m")
+
+	lipgloss.SetColorProfile(termenv.ANSI)
+	assert.Contains(t, m.View(), "\x1b[1;91mPOR\x1b[0m")
+
+	lipgloss.SetColorProfile(termenv.Ascii)
+	assert.Contains(t, m.View(), "POR")
+	assert.NotContains(t, m.View(), "\x1b[")
+}
@@ -1,33 +1,224 @@
 package styles
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"fmt"
+	"strings"
 
-var (
-	BorderStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.NormalBorder()).
-			BorderForeground(lipgloss.Color("240"))
+	"nba-tui/internal/teams"
 
-	ActiveBorderStyle = lipgloss.NewStyle().
-				BorderStyle(lipgloss.NormalBorder()).
-				BorderForeground(lipgloss.Color("2")) // Green
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
 
-	InactiveBorderStyle = lipgloss.NewStyle().
-				BorderStyle(lipgloss.NormalBorder()).
-				BorderForeground(lipgloss.Color("240"))
+// Theme is the set of styles every view renders with.
+type Theme struct {
+	Name string
+	// Plain turns off team-high bolding and +/- colors in the box score
+	// and draws charts in ASCII
+	Plain bool
+	// TeamColors tints tricodes and borders with the teams' official colors
+	TeamColors bool
+	// LightBackground picks team colors that read on a light terminal
+	LightBackground bool
 
-	TableHeaderStyle = lipgloss.NewStyle().
-				Bold(true).
-				Border(lipgloss.NormalBorder(), false, false, true, false).
-				BorderForeground(lipgloss.Color("240"))
+	Border               lipgloss.Style
+	ActiveBorder         lipgloss.Style
+	InactiveBorder       lipgloss.Style
+	FavoriteBorder       lipgloss.Style // rounded so favorites stand out without color too
+	ActiveFavoriteBorder lipgloss.Style
+	TableHeader          lipgloss.Style
+	Bold                 lipgloss.Style
+	Faint                lipgloss.Style
+	Underline            lipgloss.Style
+	Positive             lipgloss.Style // positive +/-
+	Negative             lipgloss.Style // negative +/-
+	Highlight            lipgloss.Style // search matches
+}
 
-	BoldStyle = lipgloss.NewStyle().Bold(true)
+// palette holds the colors a theme differs in. Empty colors are not set.
+type palette struct {
+	border, active, favorite, activeFavorite lipgloss.TerminalColor
+	positive, negative                       lipgloss.TerminalColor
+	highlightFg, highlightBg                 lipgloss.TerminalColor
+}
 
-	FaintStyle = lipgloss.NewStyle().Faint(true)
+func foreground(s lipgloss.Style, c lipgloss.TerminalColor) lipgloss.Style {
+	if c == nil {
+		return s
+	}
+	return s.Foreground(c)
+}
 
-	UnderlineStyle = lipgloss.NewStyle().Underline(true)
+func borderForeground(s lipgloss.Style, c lipgloss.TerminalColor) lipgloss.Style {
+	if c == nil {
+		return s
+	}
+	return s.BorderForeground(c)
+}
 
-	GreenStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
-	RedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+func newTheme(name string, p palette) Theme {
+	normal := lipgloss.NewStyle().BorderStyle(lipgloss.NormalBorder())
+	rounded := lipgloss.NewStyle().BorderStyle(lipgloss.RoundedBorder())
 
-	HighlightStyle = lipgloss.NewStyle().Background(lipgloss.Color("3")).Foreground(lipgloss.Color("0"))
-)
+	highlight := lipgloss.NewStyle()
+	if p.highlightBg == nil {
+		highlight = highlight.Reverse(true)
+	} else {
+		highlight = highlight.Background(p.highlightBg)
+	}
+
+	return Theme{
+		Name:                 name,
+		Border:               borderForeground(normal, p.border),
+		ActiveBorder:         borderForeground(normal, p.active),
+		InactiveBorder:       borderForeground(normal, p.border),
+		FavoriteBorder:       borderForeground(rounded, p.favorite),
+		ActiveFavoriteBorder: borderForeground(rounded, p.activeFavorite),
+		TableHeader: borderForeground(lipgloss.NewStyle().
+			Bold(true).
+			Border(lipgloss.NormalBorder(), false, false, true, false), p.border),
+		Bold:      lipgloss.NewStyle().Bold(true),
+		Faint:     lipgloss.NewStyle().Faint(true),
+		Underline: lipgloss.NewStyle().Underline(true),
+		Positive:  foreground(lipgloss.NewStyle(), p.positive),
+		Negative:  foreground(lipgloss.NewStyle(), p.negative),
+		Highlight: foreground(highlight, p.highlightFg),
+	}
+}
+
+// Default is the theme for dark terminals.
+func Default() Theme {
+	t := newTheme("default", palette{
+		border:         lipgloss.Color("240"),
+		active:         lipgloss.Color("2"), // Green
+		favorite:       lipgloss.Color("3"), // Yellow
+		activeFavorite: lipgloss.Color("2"),
+		positive:       lipgloss.Color("2"),
+		negative:       lipgloss.Color("1"), // Red
+		highlightFg:    lipgloss.Color("0"),
+		highlightBg:    lipgloss.Color("3"),
+	})
+	t.TeamColors = true
+	return t
+}
+
+// Light darkens the colors that wash out on a light background.
+func Light() Theme {
+	t := newTheme("light-terminal", palette{
+		border:         lipgloss.Color("248"),
+		active:         lipgloss.Color("28"),  // Dark green
+		favorite:       lipgloss.Color("130"), // Dark orange
+		activeFavorite: lipgloss.Color("28"),
+		positive:       lipgloss.Color("28"),
+		negative:       lipgloss.Color("124"), // Dark red
+		highlightFg:    lipgloss.Color("0"),
+		highlightBg:    lipgloss.Color("229"), // Pale yellow
+	})
+	t.TeamColors = true
+	t.LightBackground = true
+	return t
+}
+
+// HighContrast uses the bright colors and thick borders for the focus.
+func HighContrast() Theme {
+	t := newTheme("high-contrast", palette{
+		border:         lipgloss.Color("15"),
+		active:         lipgloss.Color("10"),
+		favorite:       lipgloss.Color("11"),
+		activeFavorite: lipgloss.Color("10"),
+		positive:       lipgloss.Color("10"),
+		negative:       lipgloss.Color("9"),
+		highlightFg:    lipgloss.Color("0"),
+		highlightBg:    lipgloss.Color("15"),
+	})
+	t.ActiveBorder = t.ActiveBorder.BorderStyle(lipgloss.ThickBorder())
+	t.Faint = lipgloss.NewStyle() // faint text is hard to read
+	return t
+}
+
+// ColorBlindSafe uses blue and orange from the Okabe-Ito palette instead
+// of green and red.
+func ColorBlindSafe() Theme {
+	t := newTheme("color-blind-safe", palette{
+		border:         lipgloss.Color("240"),
+		active:         lipgloss.Color("#56B4E9"), // Sky blue
+		favorite:       lipgloss.Color("#F0E442"), // Yellow
+		activeFavorite: lipgloss.Color("#56B4E9"),
+		positive:       lipgloss.Color("#0072B2"), // Blue
+		negative:       lipgloss.Color("#E69F00"), // Orange
+		highlightFg:    lipgloss.Color("0"),
+		highlightBg:    lipgloss.Color("#F0E442"),
+	})
+	t.TeamColors = true
+	return t
+}
+
+// Monochrome sets no colors at all. The focus is a double border and
+// search matches are reversed.
+func Monochrome() Theme {
+	t := newTheme("monochrome", palette{})
+	t.ActiveBorder = t.ActiveBorder.BorderStyle(lipgloss.DoubleBorder())
+	t.ActiveFavoriteBorder = t.ActiveFavoriteBorder.BorderStyle(lipgloss.ThickBorder())
+	return t
+}
+
+// NoDecoration keeps the default colors but turns off the decorations,
+// like --no-decoration always did.
+func NoDecoration() Theme {
+	t := Default()
+	t.Name = "no-decoration"
+	t.Plain = true
+	t.TeamColors = false
+	return t
+}
+
+// teamColor returns the team's color, or nil when the theme does not use
+// team colors, the team is unknown or the terminal has no colors. The
+// active color profile downsamples it on 256 and 16 color terminals.
+func (t Theme) teamColor(tricode string) lipgloss.TerminalColor {
+	if !t.TeamColors || lipgloss.ColorProfile() == termenv.Ascii {
+		return nil
+	}
+	team, ok := teams.Lookup(tricode)
+	if !ok {
+		return nil
+	}
+	return lipgloss.Color(team.Color(!t.LightBackground))
+}
+
+// Team returns the style of a team's tricode.
+func (t Theme) Team(tricode string) lipgloss.Style {
+	return foreground(lipgloss.NewStyle(), t.teamColor(tricode))
+}
+
+// TeamBorder tints the border of s with the team's color when it has one.
+func (t Theme) TeamBorder(s lipgloss.Style, tricode string) lipgloss.Style {
+	return borderForeground(s, t.teamColor(tricode))
+}
+
+var themes = []func() Theme{Default, Light, HighContrast, ColorBlindSafe, Monochrome, NoDecoration}
+
+// aliases are the other names Lookup accepts.
+var aliases = map[string]string{"light": "light-terminal"}
+
+// Names lists the theme names in the order they are documented.
+func Names() []string {
+	names := make([]string, 0, len(themes))
+	for _, theme := range themes {
+		names = append(names, theme().Name)
+	}
+	return names
+}
+
+// Lookup returns the theme called name.
+func Lookup(name string) (Theme, error) {
+	if alias, ok := aliases[name]; ok {
+		name = alias
+	}
+	for _, theme := range themes {
+		if t := theme(); t.Name == name {
+			return t, nil
+		}
+	}
+	return Theme{}, fmt.Errorf("unknown theme %q (%s)", name, strings.Join(Names(), "|"))
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+)
+
+var (
+	isoClockRe   = regexp.MustCompile(`^PT(\d+)M(\d+)(?:\.\d+)?S$`)
+	plainClockRe = regexp.MustCompile(`^(\d+):(\d+)(?:\.\d+)?$`)
+)
+
+// ParseClock returns the seconds of a game or action clock.
+// Both the feed's ISO form (PT11M32.00S) and MM:SS are accepted.
+func ParseClock(clock string) (int, bool) {
+	m := isoClockRe.FindStringSubmatch(clock)
+	if m == nil {
+		m = plainClockRe.FindStringSubmatch(clock)
+	}
+	if m == nil {
+		return 0, false
+	}
+	min, _ := strconv.Atoi(m[1])
+	sec, _ := strconv.Atoi(m[2])
+	return min*60 + sec, true
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		clock string
+		want  int
+		ok    bool
+	}{
+		{"PT11M32.00S", 692, true},
+		{"PT00M05.40S", 5, true},
+		{"10:45", 645, true},
+		{"0:30.5", 30, true},
+		{"", 0, false},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseClock(tt.clock)
+		assert.Equal(t, tt.ok, ok, tt.clock)
+		assert.Equal(t, tt.want, got, tt.clock)
+	}
+}